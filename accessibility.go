@@ -0,0 +1,521 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// AccessibilityNode is a node of the tree returned by GetAccessibilityTree.
+// It is roughly what a screen reader is presented with.
+type AccessibilityNode struct {
+	Role        string
+	Name        string
+	Description string
+	Value       string
+
+	// State contains the states that are set (eg. "checked", "disabled", "expanded",
+	// "selected" and "pressed"). A state that is explicitly false (eg. aria-expanded="false")
+	// is present with a value of false.
+	State map[string]bool
+
+	Children []*AccessibilityNode
+}
+
+// implicitRoles are the roles of semantic HTML elements.
+//
+// See: https://www.w3.org/TR/html-aria/#docconformance
+var implicitRoles = map[string]string{
+	"article":  "article",
+	"aside":    "complementary",
+	"button":   "button",
+	"dialog":   "dialog",
+	"fieldset": "group",
+	"details":  "group",
+	"footer":   "contentinfo",
+	"form":     "form",
+	"h1":       "heading",
+	"h2":       "heading",
+	"h3":       "heading",
+	"h4":       "heading",
+	"h5":       "heading",
+	"h6":       "heading",
+	"header":   "banner",
+	"hr":       "separator",
+	"li":       "listitem",
+	"main":     "main",
+	"nav":      "navigation",
+	"ol":       "list",
+	"option":   "option",
+	"p":        "paragraph",
+	"progress": "progressbar",
+	"summary":  "button",
+	"table":    "table",
+	"td":       "cell",
+	"textarea": "textbox",
+	"th":       "columnheader",
+	"tr":       "row",
+	"ul":       "list",
+}
+
+// inputRoles are the roles of input elements (by type).
+var inputRoles = map[string]string{
+	"":         "textbox",
+	"text":     "textbox",
+	"email":    "textbox",
+	"tel":      "textbox",
+	"url":      "textbox",
+	"password": "textbox",
+	"search":   "searchbox",
+	"checkbox": "checkbox",
+	"radio":    "radio",
+	"range":    "slider",
+	"number":   "spinbutton",
+	"button":   "button",
+	"submit":   "button",
+	"reset":    "button",
+	"image":    "button",
+}
+
+// nameFromContent are the roles whose name is computed from their content
+// when they aren't labelled.
+var nameFromContent = map[string]bool{
+	"button":       true,
+	"cell":         true,
+	"checkbox":     true,
+	"columnheader": true,
+	"heading":      true,
+	"link":         true,
+	"listitem":     true,
+	"menuitem":     true,
+	"option":       true,
+	"radio":        true,
+	"row":          true,
+	"switch":       true,
+	"tab":          true,
+	"tooltip":      true,
+	"treeitem":     true,
+}
+
+// valueRoles are the roles whose value is reported.
+var valueRoles = map[string]bool{
+	"textbox":     true,
+	"searchbox":   true,
+	"combobox":    true,
+	"slider":      true,
+	"spinbutton":  true,
+	"progressbar": true,
+}
+
+// GetAccessibilityTree returns the accessibility tree of the DOM element root. The role of
+// each element is its role attribute or the implicit role of the HTML element (eg. "button"
+// for <button>). Elements without a role (eg. <div> and <span>) are left out, and their
+// children take their place. Hidden elements (eg. aria-hidden="true") are also left out.
+// Text is represented by nodes with the role "text".
+//
+// root is always returned (with the role "generic" if it doesn't have a role).
+// nil is returned if root is nil.
+//
+// See FormatAccessibilityTree.
+func GetAccessibilityTree(root *js.Object) *AccessibilityNode {
+	if root == nil || root == js.Undefined {
+		return nil
+	}
+
+	node := accessibilityNode(root)
+	if node == nil {
+		node = &AccessibilityNode{Role: "generic", State: map[string]bool{}}
+		node.Children = accessibilityChildren(root, false)
+	}
+	return node
+}
+
+// accessibilityNode returns the node of el. nil is returned if el doesn't have a role.
+func accessibilityNode(el *js.Object) *AccessibilityNode {
+
+	role := elementRole(el)
+	if role == "" {
+		return nil
+	}
+
+	node := &AccessibilityNode{Role: role, State: map[string]bool{}}
+
+	var fromContent bool
+	node.Name, fromContent = accessibleName(el, role)
+	node.Description = accessibleDescription(el, node.Name)
+
+	if valueRoles[role] {
+		node.Value = accessibleValue(el)
+	}
+	accessibleState(el, role, node.State)
+
+	node.Children = accessibilityChildren(el, fromContent)
+	return node
+}
+
+// accessibilityChildren returns the nodes of the children of el. Text is left
+// out if the name of el was computed from it.
+func accessibilityChildren(el *js.Object, skipText bool) []*AccessibilityNode {
+
+	children := []*AccessibilityNode{}
+
+	nodes := el.Get("childNodes")
+	for i := 0; i < nodes.Length(); i++ {
+		child := nodes.Index(i)
+
+		switch child.Get("nodeType").Int() {
+		case 3: // Text
+			if skipText {
+				continue
+			}
+			if text := collapseSpace(child.Get("textContent").String()); text != "" {
+				children = append(children, &AccessibilityNode{Role: "text", Name: text, State: map[string]bool{}})
+			}
+		case 1: // Element
+			if isAccessibilityHidden(child) {
+				continue
+			}
+			if node := accessibilityNode(child); node != nil {
+				children = append(children, node)
+			} else {
+				// Promote the children of elements without a role
+				children = append(children, accessibilityChildren(child, skipText)...)
+			}
+		}
+	}
+	return children
+}
+
+// elementRole returns the explicit or implicit role of el. An empty string is returned
+// if el doesn't have a role or its role is "presentation" (or "none").
+func elementRole(el *js.Object) string {
+
+	if role, ok := attribute(el, "role"); ok {
+		// The first token is used
+		if fields := strings.Fields(role); len(fields) > 0 {
+			if fields[0] == "presentation" || fields[0] == "none" {
+				return ""
+			}
+			return fields[0]
+		}
+	}
+
+	tag := strings.ToLower(el.Get("tagName").String())
+	switch tag {
+	case "a", "area":
+		if _, ok := attribute(el, "href"); ok {
+			return "link"
+		}
+		return ""
+	case "img":
+		if alt, ok := attribute(el, "alt"); ok && alt == "" {
+			// Decorative
+			return ""
+		}
+		return "img"
+	case "input":
+		typ, _ := attribute(el, "type")
+		return inputRoles[strings.ToLower(typ)]
+	case "select":
+		if _, ok := attribute(el, "multiple"); ok {
+			return "listbox"
+		}
+		if size, _ := attribute(el, "size"); size != "" && size != "0" && size != "1" {
+			return "listbox"
+		}
+		return "combobox"
+	case "section":
+		if _, ok := attribute(el, "aria-label"); ok {
+			return "region"
+		}
+		if _, ok := attribute(el, "aria-labelledby"); ok {
+			return "region"
+		}
+		return ""
+	}
+	return implicitRoles[tag]
+}
+
+// isAccessibilityHidden returns true if el is hidden from screen readers.
+func isAccessibilityHidden(el *js.Object) bool {
+	switch strings.ToLower(el.Get("tagName").String()) {
+	case "script", "style", "template", "noscript":
+		return true
+	case "input":
+		if typ, _ := attribute(el, "type"); strings.ToLower(typ) == "hidden" {
+			return true
+		}
+	}
+
+	if v, _ := attribute(el, "aria-hidden"); v == "true" {
+		return true
+	}
+	_, hidden := attribute(el, "hidden")
+	return hidden
+}
+
+// accessibleName returns the name of el. fromContent is true if it was computed from
+// the text of el.
+//
+// See: https://www.w3.org/TR/accname-1.1/
+func accessibleName(el *js.Object, role string) (name string, fromContent bool) {
+
+	if ids, ok := attribute(el, "aria-labelledby"); ok {
+		if text := textOfIDs(el, ids); text != "" {
+			return text, false
+		}
+	}
+
+	if label, _ := attribute(el, "aria-label"); strings.TrimSpace(label) != "" {
+		return collapseSpace(label), false
+	}
+
+	switch strings.ToLower(el.Get("tagName").String()) {
+	case "img", "area":
+		if alt, _ := attribute(el, "alt"); alt != "" {
+			return collapseSpace(alt), false
+		}
+	case "input", "textarea", "select":
+		if labels := el.Get("labels"); labels != nil && labels != js.Undefined && labels.Length() > 0 {
+			texts := []string{}
+			for i := 0; i < labels.Length(); i++ {
+				texts = append(texts, collapseSpace(labels.Index(i).Get("textContent").String()))
+			}
+			return strings.Join(texts, " "), false
+		}
+		if typ, _ := attribute(el, "type"); typ == "submit" || typ == "reset" || typ == "button" {
+			if v, _ := attribute(el, "value"); v != "" {
+				return v, false
+			}
+		}
+		if placeholder, _ := attribute(el, "placeholder"); placeholder != "" {
+			return collapseSpace(placeholder), false
+		}
+	case "fieldset":
+		if legend := el.Call("querySelector", "legend"); legend != nil && legend != js.Undefined {
+			return collapseSpace(legend.Get("textContent").String()), false
+		}
+	case "table":
+		if caption := el.Call("querySelector", "caption"); caption != nil && caption != js.Undefined {
+			return collapseSpace(caption.Get("textContent").String()), false
+		}
+	}
+
+	if nameFromContent[role] {
+		if text := visibleText(el); text != "" {
+			return text, true
+		}
+	}
+
+	if title, _ := attribute(el, "title"); title != "" {
+		return collapseSpace(title), false
+	}
+	return "", false
+}
+
+// accessibleDescription returns the description of el. The title is only used
+// if it isn't the name.
+func accessibleDescription(el *js.Object, name string) string {
+
+	if ids, ok := attribute(el, "aria-describedby"); ok {
+		if text := textOfIDs(el, ids); text != "" {
+			return text
+		}
+	}
+
+	if desc, _ := attribute(el, "aria-description"); desc != "" {
+		return collapseSpace(desc)
+	}
+
+	if title, _ := attribute(el, "title"); title != "" && collapseSpace(title) != name {
+		return collapseSpace(title)
+	}
+	return ""
+}
+
+// accessibleValue returns the value of a form control (or a widget with aria-valuetext
+// or aria-valuenow).
+func accessibleValue(el *js.Object) string {
+	if v, ok := attribute(el, "aria-valuetext"); ok {
+		return v
+	}
+	if v, ok := attribute(el, "aria-valuenow"); ok {
+		return v
+	}
+	if v := el.Get("value"); v != nil && v != js.Undefined {
+		return v.String()
+	}
+	return ""
+}
+
+// accessibleState adds the states of el to state.
+func accessibleState(el *js.Object, role string, state map[string]bool) {
+
+	for _, s := range []string{"checked", "disabled", "expanded", "selected", "pressed", "required", "readonly", "invalid", "busy"} {
+		v, ok := attribute(el, "aria-"+s)
+		if !ok {
+			continue
+		}
+		switch v {
+		case "false":
+			state[s] = false
+		case "mixed":
+			// eg. a checkbox that is partially checked
+			state[s] = false
+			state["mixed"] = true
+		default:
+			state[s] = true
+		}
+	}
+
+	// Native states
+	switch role {
+	case "checkbox", "radio", "switch":
+		if _, exists := state["checked"]; !exists {
+			if checked := el.Get("checked"); checked != js.Undefined {
+				state["checked"] = checked.Bool()
+			}
+		}
+	case "option":
+		if _, exists := state["selected"]; !exists {
+			if selected := el.Get("selected"); selected != js.Undefined {
+				state["selected"] = selected.Bool()
+			}
+		}
+	}
+
+	if strings.ToLower(el.Get("tagName").String()) == "details" {
+		if _, exists := state["expanded"]; !exists {
+			_, open := attribute(el, "open")
+			state["expanded"] = open
+		}
+	}
+
+	for _, s := range []string{"disabled", "required", "readonly"} {
+		if _, exists := state[s]; exists {
+			continue
+		}
+		if _, ok := attribute(el, s); ok {
+			state[s] = true
+		}
+	}
+}
+
+// attribute returns the value of el's attribute name, and whether it is set.
+func attribute(el *js.Object, name string) (string, bool) {
+	if el.Get("getAttribute") == js.Undefined {
+		return "", false
+	}
+	v := el.Call("getAttribute", name)
+	if v == nil || v == js.Undefined {
+		return "", false
+	}
+	return v.String(), true
+}
+
+// textOfIDs returns the text of the elements with the (space separated) ids.
+func textOfIDs(el *js.Object, ids string) string {
+	doc := el.Get("ownerDocument")
+	if doc == nil || doc == js.Undefined {
+		return ""
+	}
+
+	texts := []string{}
+	for _, id := range strings.Fields(ids) {
+		if ref := doc.Call("getElementById", id); ref != nil && ref != js.Undefined {
+			if text := collapseSpace(ref.Get("textContent").String()); text != "" {
+				texts = append(texts, text)
+			}
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+// visibleText returns the text of el, excluding hidden descendants.
+func visibleText(el *js.Object) string {
+	texts := []string{}
+
+	nodes := el.Get("childNodes")
+	for i := 0; i < nodes.Length(); i++ {
+		child := nodes.Index(i)
+		switch child.Get("nodeType").Int() {
+		case 3:
+			texts = append(texts, child.Get("textContent").String())
+		case 1:
+			if !isAccessibilityHidden(child) {
+				texts = append(texts, " "+visibleText(child)+" ")
+			}
+		}
+	}
+	return collapseSpace(strings.Join(texts, ""))
+}
+
+// collapseSpace trims s and replaces runs of white space with a single space.
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// FormatAccessibilityTree returns a text representation of node that is suitable
+// for snapshot testing. Each node is on its own line (indented by its depth) with
+// its role, name, states, value and description.
+//
+// Example:
+//
+//  tree := react.GetAccessibilityTree(container)
+//  fmt.Println(react.FormatAccessibilityTree(tree))
+//
+//  // Output:
+//  // - form "Sign in"
+//  //   - textbox "Email" [required] value="a@example.com"
+//  //   - checkbox "Remember me" [checked=false]
+//  //   - button "Sign in" [disabled]
+//
+func FormatAccessibilityTree(node *AccessibilityNode) string {
+	if node == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	formatAccessibilityNode(&sb, node, 0)
+	return sb.String()
+}
+
+func formatAccessibilityNode(sb *strings.Builder, node *AccessibilityNode, depth int) {
+
+	sb.WriteString(strings.Repeat("  ", depth))
+	sb.WriteString("- ")
+	sb.WriteString(node.Role)
+
+	if node.Name != "" {
+		sb.WriteString(" " + strconv.Quote(node.Name))
+	}
+
+	if len(node.State) > 0 {
+		states := make([]string, 0, len(node.State))
+		for s, on := range node.State {
+			if on {
+				states = append(states, s)
+			} else {
+				states = append(states, s+"=false")
+			}
+		}
+		sort.Strings(states)
+		sb.WriteString(" [" + strings.Join(states, ", ") + "]")
+	}
+
+	if node.Value != "" {
+		sb.WriteString(" value=" + strconv.Quote(node.Value))
+	}
+	if node.Description != "" {
+		sb.WriteString(" description=" + strconv.Quote(node.Description))
+	}
+	sb.WriteString("\n")
+
+	for _, child := range node.Children {
+		formatAccessibilityNode(sb, child, depth+1)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// AnalyticsTracker records an analytics event. props are the converted props of
+// the element whose handler fired.
+type AnalyticsTracker func(event string, props map[string]interface{})
+
+// analyticsTracker is set by RegisterAnalyticsTracker.
+var analyticsTracker AnalyticsTracker
+
+// RegisterAnalyticsTracker sets the AnalyticsTracker used by the "track" tag option.
+// When a struct is converted by SToMap, the handler of a field with the option
+// (eg. `react:"onClick,track=button_click"`) also calls fn with the event name
+// and the converted props. The original handler is called first.
+//
+// Example:
+//
+//  react.RegisterAnalyticsTracker(func(event string, props map[string]interface{}) {
+//      js.Global.Get("analytics").Call("track", event, js.M{"id": props["id"]})
+//  })
+//
+//  type ButtonProps struct {
+//      ID      string                      `react:"id"`
+//      OnClick func(*react.SyntheticEvent) `react:"onClick,track=button_click"`
+//  }
+//
+func RegisterAnalyticsTracker(fn AnalyticsTracker) {
+	analyticsTracker = fn
+}
+
+// applyTracking wraps the handler at key so that it also calls the AnalyticsTracker.
+func applyTracking(out map[string]interface{}, key string, event string) {
+
+	handler := toJSFunc(out[key])
+	if handler == nil {
+		return
+	}
+
+	// A snapshot of the converted props
+	props := make(map[string]interface{}, len(out))
+	for k, v := range out {
+		props[k] = v
+	}
+
+	out[key] = func(arguments ...*js.Object) {
+		args := make([]interface{}, len(arguments))
+		for i := range arguments {
+			args[i] = arguments[i]
+		}
+		handler.Invoke(args...)
+
+		if analyticsTracker != nil {
+			analyticsTracker(event, props)
+		}
+	}
+}
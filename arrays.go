@@ -0,0 +1,87 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react/forks/mapstructure"
+)
+
+// ToJSArray converts a slice (or array) to a javascript array. Structs and maps are
+// converted using SToMap (including those in nested slices), while javascript objects
+// and primitives are kept as is. A nil slice returns nil.
+//
+// Example:
+//
+//  arr, err := react.ToJSArray([]Todo{{Text: "Write docs"}, {Text: "Ship"}})
+//
+func ToJSArray(slice interface{}) (_ *js.Object, rErr error) {
+
+	v, isNil := indirect(reflect.ValueOf(slice))
+	if !v.IsValid() {
+		return nil, &ConversionError{Kind: reflect.Invalid, Msg: "ToJSArray: argument must be a slice or array"}
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, &ConversionError{Kind: v.Kind(), Msg: "ToJSArray: argument must be a slice or array"}
+	}
+	if isNil || (v.Kind() == reflect.Slice && v.IsNil()) {
+		return nil, nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			cErr, ok := r.(*ConversionError)
+			if !ok {
+				panic(r)
+			}
+			rErr = cErr
+		}
+	}()
+
+	arr := js.Global.Get("Array").New()
+	for _, e := range convertSlice(v, defaultOptions) {
+		arr.Call("push", e)
+	}
+	return arr, nil
+}
+
+// FromJSArray decodes the javascript array arr into the slice that destSlicePtr
+// points to. Each element is decoded the same way as UnmarshalStruct (including
+// nested arrays). If arr is undefined or null, the slice is set to nil.
+//
+// Example:
+//
+//  var todos []Todo
+//  err := react.FromJSArray(arr, &todos)
+//
+func FromJSArray(arr *js.Object, destSlicePtr interface{}) error {
+
+	dest := reflect.ValueOf(destSlicePtr)
+	if dest.Kind() != reflect.Ptr || dest.IsNil() || dest.Elem().Kind() != reflect.Slice {
+		return &ConversionError{Kind: dest.Kind(), Msg: "FromJSArray: destination must be a pointer to a slice"}
+	}
+
+	if arr == nil || arr == js.Undefined {
+		dest.Elem().Set(reflect.Zero(dest.Elem().Type()))
+		return nil
+	}
+
+	if !js.Global.Get("Array").Call("isArray", arr).Bool() {
+		return &ConversionError{Kind: reflect.ValueOf(arr.Interface()).Kind(), Msg: "FromJSArray: argument is not an array"}
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ZeroFields: true,
+		TagName:    "react",
+		FieldKey:   reactFieldKey,
+		DecodeHook: enumDecodeHook,
+		Result:     destSlicePtr,
+	})
+	if err != nil {
+		return &ConversionError{Kind: dest.Kind(), Msg: err.Error()}
+	}
+
+	return decoder.Decode(arr.Interface())
+}
@@ -0,0 +1,89 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// autofocusedKey is the property of the DOM node that records if it has been focused.
+const autofocusedKey = "__reactAutofocused"
+
+// applyAutofocus wires a ref that focuses the element. It is used for the "autofocus"
+// tag option, which is placed on a bool field. Unlike the autoFocus attribute (which
+// is unreliable in single page apps), focus() is actively called when the element
+// is committed to the DOM.
+//
+// Example:
+//
+//  type InputProps struct {
+//      Ref   *js.Object `react:"ref,omitempty"`
+//      Focus bool       `react:",autofocus"`
+//  }
+//
+//  // Focus the input when the dialog opens
+//  react.JSX("input", &InputProps{Focus: dialogOpen})
+//
+// With "autofocus", the element is focused when it mounts (if the field is true) and again
+// whenever the field changes from false to true. With "autofocus=mount", the element is only
+// focused when it mounts (if the field is true). The field itself is not added to the props,
+// and an existing ref (object or callback) is still set.
+func applyAutofocus(out map[string]interface{}, focus bool, mountOnly bool) {
+
+	// toJSFunc passes a ref object through
+	prev := toJSFunc(out["ref"])
+
+	out["ref"] = func(node *js.Object) {
+		// Keep the existing ref working
+		if prev != nil {
+			if prev.Get("call") != js.Undefined {
+				prev.Invoke(node)
+			} else {
+				prev.Set("current", node)
+			}
+		}
+
+		if node == nil {
+			return
+		}
+
+		// A new ref function is created for every conversion,
+		// so React calls it after every render.
+		if mountOnly {
+			if node.Get(autofocusedKey) != js.Undefined {
+				return
+			}
+			node.Set(autofocusedKey, true)
+			if focus {
+				node.Call("focus")
+			}
+			return
+		}
+
+		if !focus {
+			node.Set(autofocusedKey, false)
+			return
+		}
+
+		if !node.Get(autofocusedKey).Bool() {
+			node.Set(autofocusedKey, true)
+			node.Call("focus")
+		}
+	}
+}
+
+// autofocusValue returns the value of a bool field (or a pointer to a bool) with the
+// "autofocus" tag option. A nil pointer is false.
+func autofocusValue(v reflect.Value) bool {
+	v, isNil := indirect(v)
+	if isNil {
+		return false
+	}
+
+	if v.Kind() != reflect.Bool {
+		panic(&ConversionError{Kind: v.Kind(), Msg: "autofocus field must be a bool"})
+	}
+	return v.Bool()
+}
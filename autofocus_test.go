@@ -0,0 +1,78 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+// fakeFocusNode returns a javascript object that records calls to focus.
+func fakeFocusNode(focused *int) *js.Object {
+	node := js.Global.Get("Object").New()
+	node.Set("focus", func() {
+		*focused++
+	})
+	return node
+}
+
+func TestSToMapAutofocusPointer(t *testing.T) {
+
+	type props struct {
+		Focus *bool `react:",autofocus"`
+	}
+
+	yes := true
+
+	tests := []struct {
+		name string
+		in   props
+		want int
+	}{
+		{"nil pointer", props{}, 0},
+		{"true", props{Focus: &yes}, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mp, err := SToMapE(tc.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ref, ok := mp["ref"].(func(*js.Object))
+			if !ok {
+				t.Fatalf("expected a ref callback, got: %T", mp["ref"])
+			}
+
+			focused := 0
+			ref(fakeFocusNode(&focused))
+			if focused != tc.want {
+				t.Errorf("expected focus to be called %d times, got %d", tc.want, focused)
+			}
+		})
+	}
+}
+
+func TestSToMapAutofocusNotBool(t *testing.T) {
+
+	type props struct {
+		Focus int `react:",autofocus"`
+	}
+
+	_, err := SToMapE(props{Focus: 1})
+
+	cErr, ok := err.(*ConversionError)
+	if !ok {
+		t.Fatalf("expected a *ConversionError, got: %#v", err)
+	}
+	if cErr.Path != "Focus" {
+		t.Errorf("expected the path to be Focus, got: %q", cErr.Path)
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// BreadcrumbItem is an entry of the trail returned by UseBreadcrumbs.
+type BreadcrumbItem struct {
+	Label string `react:"label"`
+	Href  string `react:"href"`
+}
+
+// BreadcrumbsOptions configures BreadcrumbsProvider.
+type BreadcrumbsOptions struct {
+	// Separator is placed between the labels in the document's title.
+	// The default is " › ".
+	Separator string
+
+	// RootFirst orders the labels in the document's title from the outermost
+	// Breadcrumb (eg. "App › Section › Item"). By default, the innermost is first
+	// (eg. "Item › Section › App").
+	RootFirst bool
+
+	// DisableTitle stops the document's title from being set.
+	DisableTitle bool
+}
+
+type breadcrumbEntry struct {
+	item  BreadcrumbItem
+	depth int
+	seq   int // mount order (the id of the entry)
+}
+
+// breadcrumbCollector is provided by BreadcrumbsProvider. The Breadcrumbs register
+// themselves with it while they are mounted.
+type breadcrumbCollector struct {
+	opts BreadcrumbsOptions
+
+	entries map[int]*breadcrumbEntry
+	nextID  int
+	trail   []BreadcrumbItem
+
+	// subscribers are notified when the trail changes.
+	subscribers map[int]func()
+	nextSubID   int
+
+	flushScheduled bool
+	originalTitle  *js.Object
+}
+
+// changed schedules the trail to be recomputed. Changes made in the same tick (eg. a
+// Breadcrumb unmounting and another mounting during a route transition) are coalesced,
+// so the trail doesn't flicker.
+func (c *breadcrumbCollector) changed() {
+	if c.flushScheduled {
+		return
+	}
+	c.flushScheduled = true
+
+	js.Global.Call("setTimeout", func() {
+		c.flushScheduled = false
+		c.flush()
+	}, 0)
+}
+
+func (c *breadcrumbCollector) flush() {
+
+	entries := make([]*breadcrumbEntry, 0, len(c.entries))
+	for _, e := range c.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].depth != entries[j].depth {
+			return entries[i].depth < entries[j].depth
+		}
+		return entries[i].seq < entries[j].seq
+	})
+
+	trail := make([]BreadcrumbItem, len(entries))
+	for i, e := range entries {
+		trail[i] = e.item
+	}
+
+	if breadcrumbsEqual(trail, c.trail) {
+		return
+	}
+	c.trail = trail
+
+	c.setTitle()
+
+	BatchedUpdates(func() {
+		for _, fn := range c.subscribers {
+			fn()
+		}
+	})
+}
+
+// setTitle sets the document's title to the labels of the trail.
+func (c *breadcrumbCollector) setTitle() {
+	if c.opts.DisableTitle {
+		return
+	}
+
+	document := js.Global.Get("document")
+	if document == js.Undefined {
+		return
+	}
+
+	if len(c.trail) == 0 {
+		if c.originalTitle != nil {
+			document.Set("title", c.originalTitle)
+		}
+		return
+	}
+
+	if c.originalTitle == nil {
+		c.originalTitle = document.Get("title")
+	}
+
+	sep := c.opts.Separator
+	if sep == "" {
+		sep = " › "
+	}
+
+	labels := make([]string, len(c.trail))
+	for i, item := range c.trail {
+		if c.opts.RootFirst {
+			labels[i] = item.Label
+		} else {
+			labels[len(c.trail)-1-i] = item.Label
+		}
+	}
+	document.Set("title", strings.Join(labels, sep))
+}
+
+func breadcrumbsEqual(a, b []BreadcrumbItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// breadcrumbsContext provides the breadcrumbCollector.
+	breadcrumbsContext *js.Object
+
+	// breadcrumbDepthContext provides the number of Breadcrumbs that are ancestors.
+	breadcrumbDepthContext *js.Object
+)
+
+func getBreadcrumbsContexts() (collector *js.Object, depth *js.Object) {
+	if breadcrumbsContext == nil {
+		breadcrumbsContext = React.Call("createContext", nil)
+		breadcrumbDepthContext = React.Call("createContext", 0)
+	}
+	return breadcrumbsContext, breadcrumbDepthContext
+}
+
+// BreadcrumbsProvider collects the Breadcrumbs rendered by its children. The trail is
+// returned by UseBreadcrumbs and the document's title is set to its labels.
+//
+// Example:
+//
+//  react.BreadcrumbsProvider(react.BreadcrumbsOptions{},
+//      react.Breadcrumb("App", "/",
+//          react.JSX(Router, nil),
+//      ),
+//  )
+//
+func BreadcrumbsProvider(opts BreadcrumbsOptions, children ...interface{}) interface{} {
+	props := map[string]interface{}{
+		"opts":     wrapBox(opts),
+		"children": children,
+	}
+	return JSX(breadcrumbsProviderComponent, props)
+}
+
+// breadcrumbsProviderComponent is the functional component used by BreadcrumbsProvider.
+var breadcrumbsProviderComponent = NamedComponent("BreadcrumbsProvider", func(this *js.Object, arguments []*js.Object) interface{} {
+	props := arguments[0]
+	opts := unwrapBox(props.Get("opts")).(BreadcrumbsOptions)
+
+	// The collector (and the box that provides it) must persist, otherwise all
+	// the consumers re-render whenever the provider does.
+	type providerState struct {
+		c   *breadcrumbCollector
+		box *js.Object
+	}
+
+	st := useGoRef(func() interface{} {
+		c := &breadcrumbCollector{
+			entries:     map[int]*breadcrumbEntry{},
+			subscribers: map[int]func(){},
+		}
+		return &providerState{c: c, box: wrapBox(c)}
+	}).(*providerState)
+	st.c.opts = opts
+
+	useEffect(func() func() {
+		return func() {
+			// Restore the title
+			st.c.entries = map[int]*breadcrumbEntry{}
+			st.c.flush()
+		}
+	}, []interface{}{})
+
+	ctx, _ := getBreadcrumbsContexts()
+	return JSX(ctx.Get("Provider"), map[string]interface{}{"value": st.box}, props.Get("children"))
+})
+
+// useBreadcrumbCollector returns the collector provided by the nearest BreadcrumbsProvider.
+// box is the context's value, which (unlike the collector) can be used as a dependency of an effect.
+func useBreadcrumbCollector() (_ *breadcrumbCollector, box *js.Object) {
+	ctx, _ := getBreadcrumbsContexts()
+	v := React.Call("useContext", ctx)
+	if v == nil || v == js.Undefined {
+		return nil, nil
+	}
+	return unwrapBox(v).(*breadcrumbCollector), v
+}
+
+// Breadcrumb adds an entry to the trail of the nearest BreadcrumbsProvider while it is
+// mounted. It renders children (if any), and the Breadcrumbs inside children come after it
+// in the trail. Breadcrumbs with the same number of ancestor Breadcrumbs are ordered by
+// when they mounted.
+//
+// Example:
+//
+//  // Inside the section's component
+//  return react.Breadcrumb("Settings", "/settings",
+//      react.JSX(SettingsPage, nil),
+//  )
+//
+func Breadcrumb(label string, href string, children ...interface{}) interface{} {
+	props := map[string]interface{}{
+		"label":    label,
+		"href":     href,
+		"children": children,
+	}
+	return JSX(breadcrumbComponent, props)
+}
+
+// breadcrumbComponent is the functional component used by Breadcrumb.
+var breadcrumbComponent = NamedComponent("Breadcrumb", func(this *js.Object, arguments []*js.Object) interface{} {
+	props := arguments[0]
+	item := BreadcrumbItem{Label: props.Get("label").String(), Href: props.Get("href").String()}
+
+	c, box := useBreadcrumbCollector()
+	_, depthCtx := getBreadcrumbsContexts()
+	depth := React.Call("useContext", depthCtx).Int()
+
+	type breadcrumbState struct {
+		id int
+	}
+
+	st := useGoRef(func() interface{} {
+		return &breadcrumbState{}
+	}).(*breadcrumbState)
+
+	// A layout effect registers the entry before the browser paints
+	useLayoutEffect(func() func() {
+		if c == nil {
+			return nil
+		}
+
+		c.nextID++
+		id := c.nextID
+		st.id = id
+		c.entries[id] = &breadcrumbEntry{depth: depth, seq: id}
+		return func() {
+			delete(c.entries, id)
+			c.changed()
+		}
+	}, []interface{}{box, depth})
+
+	useLayoutEffect(func() func() {
+		if c == nil {
+			return nil
+		}
+		if e := c.entries[st.id]; e != nil && e.item != item {
+			e.item = item
+			c.changed()
+		}
+		return nil
+	}, []interface{}{item.Label, item.Href, box, depth})
+
+	return JSX(depthCtx.Get("Provider"), map[string]interface{}{"value": depth + 1}, props.Get("children"))
+})
+
+// UseBreadcrumbs is a hook that returns the trail of the nearest BreadcrumbsProvider,
+// ordered from the outermost Breadcrumb. The component re-renders when the trail changes.
+//
+// Example:
+//
+//  trail := react.UseBreadcrumbs()
+//  for _, b := range trail {
+//      links = append(links, elements.A(&elements.AProps{Href: b.Href}, b.Label))
+//  }
+//
+func UseBreadcrumbs() []BreadcrumbItem {
+
+	c, box := useBreadcrumbCollector()
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		if c == nil {
+			return nil
+		}
+
+		version := 0
+		c.nextSubID++
+		id := c.nextSubID
+		c.subscribers[id] = func() {
+			version++
+			setVersion(version)
+		}
+		return func() {
+			delete(c.subscribers, id)
+		}
+	}, []interface{}{box})
+
+	if c == nil {
+		return nil
+	}
+	return c.trail
+}
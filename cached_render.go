@@ -0,0 +1,167 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"container/list"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// renderCache is a LRU cache of elements.
+type renderCache struct {
+	ll    *list.List // front is the most recently used
+	items map[string]*list.Element
+}
+
+type renderCacheEntry struct {
+	key     string
+	element *js.Object
+}
+
+// renderCaches stores a renderCache for each cache key used with CachedRender.
+var renderCaches = map[string]*renderCache{}
+
+// CachedRender returns the element created by build. If build was previously called
+// with identical props for the same cacheKey, the cached element is returned instead.
+// It is used for subtrees that are expensive to create (eg. syntax-highlighted code or markdown).
+//
+// props is converted using SToMap and serialized with sorted keys. The serialized props are
+// stored (and compared) in full, so 2 different props can never share an element.
+// Since a cached element would keep calling stale callbacks, props must not contain
+// functions (including event handlers and javascript functions). Otherwise a
+// *ConversionError is panicked. Callbacks should be attached outside the cached subtree.
+//
+// Each cacheKey has its own LRU cache that keeps at most capacity elements.
+// The memory used is proportional to capacity multiplied by the size of the elements
+// and their serialized props. The caches are never cleared automatically,
+// so use InvalidateCachedRender if the memory is needed (or build's output would change).
+//
+// Example:
+//
+//  react.CachedRender("markdown", &MarkdownProps{Source: src}, func() *js.Object {
+//      return renderMarkdown(src)
+//  }, 50)
+//
+func CachedRender(cacheKey string, props interface{}, build func() *js.Object, capacity int) *js.Object {
+
+	if capacity <= 0 {
+		return build()
+	}
+
+	var b strings.Builder
+	writeStableKey(&b, SToMap(props))
+	key := b.String()
+
+	c := renderCaches[cacheKey]
+	if c == nil {
+		c = &renderCache{ll: list.New(), items: map[string]*list.Element{}}
+		renderCaches[cacheKey] = c
+	}
+
+	if e, exists := c.items[key]; exists {
+		c.ll.MoveToFront(e)
+		return e.Value.(*renderCacheEntry).element
+	}
+
+	element := build()
+	c.items[key] = c.ll.PushFront(&renderCacheEntry{key: key, element: element})
+
+	for c.ll.Len() > capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*renderCacheEntry).key)
+	}
+
+	return element
+}
+
+// InvalidateCachedRender removes all the elements cached by CachedRender for cacheKey.
+func InvalidateCachedRender(cacheKey string) {
+	delete(renderCaches, cacheKey)
+}
+
+// writeStableKey serializes v. Unlike JSON.stringify, map keys are sorted.
+func writeStableKey(b *strings.Builder, v interface{}) {
+
+	if v == nil || jsObjectIsNil(v) {
+		b.WriteString("null")
+		return
+	}
+
+	if o, ok := v.(*js.Object); ok {
+		if o.Get("constructor") == js.Global.Get("Function") {
+			panic(errCachedRenderFunc)
+		}
+		if s := js.Global.Get("JSON").Call("stringify", o); s != js.Undefined {
+			b.WriteString(s.String())
+		} else {
+			b.WriteString("null")
+		}
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(rv.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b.WriteString(strconv.FormatUint(rv.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		b.WriteString(strconv.FormatFloat(rv.Float(), 'g', -1, 64))
+	case reflect.String:
+		b.WriteString(strconv.Quote(rv.String()))
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			b.WriteString("null")
+			return
+		}
+		writeStableKey(b, rv.Elem().Interface())
+	case reflect.Struct:
+		writeStableKey(b, SToMap(v))
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		vals := map[string]interface{}{}
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := iter.Key().String()
+			keys = append(keys, k)
+			vals[k] = iter.Value().Interface()
+		}
+		sort.Strings(keys)
+
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(strconv.Quote(k))
+			b.WriteByte(':')
+			writeStableKey(b, vals[k])
+		}
+		b.WriteByte('}')
+	case reflect.Slice, reflect.Array:
+		b.WriteByte('[')
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeStableKey(b, rv.Index(i).Interface())
+		}
+		b.WriteByte(']')
+	case reflect.Func:
+		panic(errCachedRenderFunc)
+	default:
+		// Channels are ignored
+		b.WriteString("null")
+	}
+}
+
+var errCachedRenderFunc = &ConversionError{Kind: reflect.Func, Msg: "CachedRender: props must not contain functions"}
@@ -0,0 +1,93 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test in Node.js with react, react-dom (16 or 17)
+// and jsdom installed.
+
+type markdownProps struct {
+	Source string `react:"source"`
+}
+
+// testMarkdown returns a page of n markdown sections.
+func testMarkdown(n int) []string {
+	sections := make([]string, n)
+	for i := range sections {
+		sections[i] = "# Section " + strconv.Itoa(i) + "\n\n" +
+			strings.Repeat("Some *markdown* text with a [link](https://example.com).\n", 20) +
+			"- one\n- two\n- three\n"
+	}
+	return sections
+}
+
+// renderTestMarkdown is a (very) simple markdown renderer.
+func renderTestMarkdown(src string) *js.Object {
+	children := []interface{}{}
+	items := []interface{}{}
+	for _, line := range strings.Split(src, "\n") {
+		switch {
+		case strings.HasPrefix(line, "# "):
+			children = append(children, JSX("h1", nil, line[2:]))
+		case strings.HasPrefix(line, "- "):
+			items = append(items, JSX("li", nil, line[2:]))
+		case line != "":
+			children = append(children, JSX("p", nil, line))
+		}
+	}
+	return JSX("section", nil, append(children, JSX("ul", nil, items...))...)
+}
+
+func TestCachedRenderFuncs(t *testing.T) {
+	setupDOM(t)
+
+	type props struct {
+		Source  string                `react:"source"`
+		OnClick func(*SyntheticEvent) `react:"onClick"`
+	}
+
+	defer InvalidateCachedRender("test")
+	defer func() {
+		if _, ok := recover().(*ConversionError); !ok {
+			t.Errorf("expected a *ConversionError")
+		}
+	}()
+
+	CachedRender("test", &props{Source: "# Title", OnClick: func(*SyntheticEvent) {}}, func() *js.Object {
+		return renderTestMarkdown("# Title")
+	}, 10)
+}
+
+func BenchmarkCachedRender(b *testing.B) {
+	setupDOM(b)
+	page := testMarkdown(100)
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, src := range page {
+				renderTestMarkdown(src)
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		defer InvalidateCachedRender("markdown")
+		for i := 0; i < b.N; i++ {
+			for _, src := range page {
+				CachedRender("markdown", &markdownProps{Source: src}, func() *js.Object {
+					return renderTestMarkdown(src)
+				}, len(page))
+			}
+		}
+	})
+}
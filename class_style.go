@@ -0,0 +1,197 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ClassName is used to build the className prop. The classes whose value is true
+// are joined with spaces when converted by SToMap (either as a struct field or a map value).
+// It mirrors the classnames npm package.
+//
+// Example:
+//
+//  type ButtonProps struct {
+//      ClassName react.ClassName `react:"className,omitempty"`
+//  }
+//
+//  &ButtonProps{ClassName: react.Classes("btn", react.ClassName{"btn-active": active, "btn-disabled": disabled})}
+//
+// See: https://www.npmjs.com/package/classnames
+type ClassName map[string]bool
+
+// Classes creates a ClassName. Each argument can be a string (which can contain multiple
+// classes separated by spaces), a ClassName or a map[string]bool. Empty strings and nil are ignored.
+//
+// Example:
+//
+//  react.Classes("btn btn-lg", react.ClassName{"active": isActive})
+//
+func Classes(args ...interface{}) ClassName {
+	out := ClassName{}
+
+	for _, arg := range args {
+		switch x := arg.(type) {
+		case nil:
+		case string:
+			for _, class := range strings.Fields(x) {
+				out[class] = true
+			}
+		case ClassName:
+			for class, on := range x {
+				out[class] = out[class] || on
+			}
+		case map[string]bool:
+			for class, on := range x {
+				out[class] = out[class] || on
+			}
+		default:
+			panic("Classes arguments must be strings or maps of classes")
+		}
+	}
+
+	return out
+}
+
+// String returns the classes whose value is true, sorted and joined with spaces.
+func (c ClassName) String() string {
+	classes := make([]string, 0, len(c))
+	for class, on := range c {
+		if on && class != "" {
+			classes = append(classes, class)
+		}
+	}
+	sort.Strings(classes)
+	return strings.Join(classes, " ")
+}
+
+// Style is used to build the style prop. When converted by SToMap (either as a struct field
+// or a map value), the keys are converted to camelCase (eg. "background-color" becomes
+// "backgroundColor") and "px" is appended to numbers for properties that are not unitless.
+// Custom properties (eg. "--main-color") are kept as is.
+//
+// Example:
+//
+//  react.JSX("div", js.M{"style": react.Style{"background-color": "red", "width": 100, "opacity": 0.5}})
+//
+// See: https://reactjs.org/docs/dom-elements.html#style
+type Style map[string]interface{}
+
+// Map returns the style object that React expects.
+func (s Style) Map() map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+
+	out := make(map[string]interface{}, len(s))
+	for prop, val := range s {
+		prop = styleKey(prop)
+		out[prop] = styleValue(prop, val)
+	}
+	return out
+}
+
+// styleKey converts a CSS property to the camelCase form used by React.
+func styleKey(prop string) string {
+	if strings.HasPrefix(prop, "--") || !strings.Contains(prop, "-") {
+		return prop
+	}
+
+	// Vendor prefixes are capitalized, except for "ms"
+	if strings.HasPrefix(prop, "-") && !strings.HasPrefix(prop, "-ms-") {
+		prop = prop[1:]
+		prop = strings.ToUpper(prop[:1]) + prop[1:]
+	} else {
+		prop = strings.TrimPrefix(prop, "-")
+	}
+
+	parts := strings.Split(prop, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// styleValue appends "px" to numbers for properties that are not unitless.
+func styleValue(prop string, val interface{}) interface{} {
+	if _, unitless := unitlessProperties[prop]; unitless || strings.HasPrefix(prop, "--") {
+		return val
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() == 0 {
+			return "0"
+		}
+		return strconv.FormatInt(v.Int(), 10) + "px"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() == 0 {
+			return "0"
+		}
+		return strconv.FormatUint(v.Uint(), 10) + "px"
+	case reflect.Float32, reflect.Float64:
+		if v.Float() == 0 {
+			return "0"
+		}
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64) + "px"
+	}
+	return val
+}
+
+// unitlessProperties are the properties that accept numbers without units.
+// It is the same list that React uses.
+var unitlessProperties = map[string]struct{}{
+	"animationIterationCount": {}, "borderImageOutset": {}, "borderImageSlice": {}, "borderImageWidth": {},
+	"boxFlex": {}, "boxFlexGroup": {}, "boxOrdinalGroup": {}, "columnCount": {}, "columns": {},
+	"flex": {}, "flexGrow": {}, "flexPositive": {}, "flexShrink": {}, "flexNegative": {}, "flexOrder": {},
+	"gridArea": {}, "gridRow": {}, "gridRowEnd": {}, "gridRowSpan": {}, "gridRowStart": {},
+	"gridColumn": {}, "gridColumnEnd": {}, "gridColumnSpan": {}, "gridColumnStart": {},
+	"fontWeight": {}, "lineClamp": {}, "lineHeight": {}, "opacity": {}, "order": {}, "orphans": {},
+	"tabSize": {}, "widows": {}, "zIndex": {}, "zoom": {},
+	"fillOpacity": {}, "floodOpacity": {}, "stopOpacity": {}, "strokeDasharray": {}, "strokeDashoffset": {},
+	"strokeMiterlimit": {}, "strokeOpacity": {}, "strokeWidth": {},
+}
+
+// convertClassStyle converts a ClassName or Style. ok is false for other values.
+func convertClassStyle(v interface{}) (_ interface{}, ok bool) {
+	switch x := v.(type) {
+	case ClassName:
+		return x.String(), true
+	case Style:
+		return x.Map(), true
+	}
+	return nil, false
+}
+
+// convertMapValues converts the ClassName and Style values of mp.
+// mp is returned unchanged if there are none.
+func convertMapValues(mp map[string]interface{}) map[string]interface{} {
+	var out map[string]interface{}
+
+	for k, v := range mp {
+		conv, ok := convertClassStyle(v)
+		if !ok {
+			continue
+		}
+
+		if out == nil {
+			out = make(map[string]interface{}, len(mp))
+			for k, v := range mp {
+				out[k] = v
+			}
+		}
+		out[k] = conv
+	}
+
+	if out == nil {
+		return mp
+	}
+	return out
+}
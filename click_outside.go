@@ -0,0 +1,91 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !native
+// +build !native
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// OnClickOutside calls fn when the user presses (mouse, touch or pen) outside the element
+// of ref. It is used to close dropdowns, popovers and menus. The returned function removes the listener.
+//
+// Elements rendered in a portal are not descendants of ref's element in the DOM, so
+// refs to them (or any other element that should count as inside) can be provided as inside.
+//
+// The listener uses pointerdown in the capture phase, so it fires even if a handler stops
+// propagation. Presses on elements that have been removed from the DOM (eg. by an earlier
+// handler) are ignored. Focusing an iframe outside the element also calls fn with the blur event.
+//
+// Example:
+//
+//  remove := react.OnClickOutside(ref, func(e *js.Object) {
+//      setOpen(false)
+//  })
+//
+func OnClickOutside(ref *js.Object, fn func(e *js.Object), inside ...*js.Object) (remove func()) {
+
+	refs := append([]*js.Object{ref}, inside...)
+
+	contains := func(target *js.Object) bool {
+		for _, r := range refs {
+			if r == nil {
+				continue
+			}
+			if node := r.Get("current"); node != nil && node != js.Undefined && node.Call("contains", target).Bool() {
+				return true
+			}
+		}
+		return false
+	}
+
+	onPointerDown := func(e *js.Object) {
+		target := e.Get("target")
+		if target == nil || !target.Get("isConnected").Bool() {
+			// Removed from the DOM before the handler ran
+			return
+		}
+		if !contains(target) {
+			fn(e)
+		}
+	}
+
+	onBlur := func(e *js.Object) {
+		// Clicking an iframe does not dispatch events to the document
+		active := js.Global.Get("document").Get("activeElement")
+		if active != nil && active.Get("tagName").String() == "IFRAME" && !contains(active) {
+			fn(e)
+		}
+	}
+
+	document := js.Global.Get("document")
+	document.Call("addEventListener", "pointerdown", onPointerDown, true)
+	js.Global.Call("addEventListener", "blur", onBlur)
+
+	return func() {
+		document.Call("removeEventListener", "pointerdown", onPointerDown, true)
+		js.Global.Call("removeEventListener", "blur", onBlur)
+	}
+}
+
+// UseClickOutside is a hook that uses OnClickOutside while the component is mounted.
+// The latest fn is always called.
+func UseClickOutside(ref *js.Object, fn func(e *js.Object), inside ...*js.Object) {
+
+	type clickOutsideState struct {
+		fn func(e *js.Object)
+	}
+
+	st := useGoRef(func() interface{} {
+		return &clickOutsideState{}
+	}).(*clickOutsideState)
+	st.fn = fn
+
+	useEffect(func() func() {
+		return OnClickOutside(ref, func(e *js.Object) {
+			st.fn(e)
+		}, inside...)
+	}, []interface{}{})
+}
@@ -0,0 +1,18 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build native
+// +build native
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// OnClickOutside does nothing in React Native.
+func OnClickOutside(ref *js.Object, fn func(e *js.Object), inside ...*js.Object) (remove func()) {
+	return func() {}
+}
+
+// UseClickOutside does nothing in React Native.
+func UseClickOutside(ref *js.Object, fn func(e *js.Object), inside ...*js.Object) {}
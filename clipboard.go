@@ -0,0 +1,105 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ErrClipboardUnavailable is returned by CopyToClipboard when the browser
+// doesn't support copying text.
+var ErrClipboardUnavailable = errors.New("react: clipboard is unavailable")
+
+// CopyToClipboard copies text to the clipboard using navigator.clipboard.writeText.
+// If the Clipboard API is not available (eg. the page is not served over https),
+// document.execCommand("copy") is used instead.
+//
+// NOTE: It must be called from a goroutine.
+func CopyToClipboard(text string) error {
+
+	if IsNative() {
+		return ErrNotSupported
+	}
+
+	clipboard := js.Global.Get("navigator")
+	if clipboard != js.Undefined {
+		clipboard = clipboard.Get("clipboard")
+	}
+
+	if clipboard != js.Undefined && clipboard != nil && clipboard.Get("writeText") != js.Undefined {
+		_, err := JSFnPromise("navigator.clipboard.writeText", text)
+		return err
+	}
+
+	// Fallback for older browsers
+	document := js.Global.Get("document")
+	if document == js.Undefined || document.Get("execCommand") == js.Undefined {
+		return ErrClipboardUnavailable
+	}
+
+	textarea := document.Call("createElement", "textarea")
+	textarea.Set("value", text)
+	textarea.Call("setAttribute", "readonly", "")
+	textarea.Get("style").Set("position", "fixed")
+	textarea.Get("style").Set("opacity", "0")
+	document.Get("body").Call("appendChild", textarea)
+	textarea.Call("select")
+
+	ok := document.Call("execCommand", "copy").Bool()
+	document.Get("body").Call("removeChild", textarea)
+
+	if !ok {
+		return ErrClipboardUnavailable
+	}
+	return nil
+}
+
+// copyTextValue returns the text of a field with the "copytext" tag option.
+// ok is false if the field is a nil pointer.
+func copyTextValue(v reflect.Value) (_ string, ok bool) {
+	v, isNil := indirect(v)
+	if isNil {
+		return "", false
+	}
+
+	if v.Kind() != reflect.String {
+		panic(&ConversionError{Kind: v.Kind(), Msg: "copytext field must be a string"})
+	}
+	return v.String(), true
+}
+
+// applyCopyText adds an onClick handler that copies text to the clipboard.
+// It is used for the "copytext" tag option. An existing onClick handler is called
+// first and the text is not copied if it calls preventDefault.
+//
+// Example:
+//
+//  type CopyButtonProps struct {
+//      Text string `react:",copytext"`
+//  }
+//
+//  elements.Button(&CopyButtonProps{Text: url}, "Copy link")
+//
+func applyCopyText(out map[string]interface{}, text string) {
+
+	prev := toJSFunc(out["onClick"])
+
+	out["onClick"] = func(e *js.Object) {
+		if prev != nil {
+			prev.Invoke(e)
+		}
+
+		if e.Call("isDefaultPrevented").Bool() {
+			return
+		}
+
+		go func() {
+			if err := CopyToClipboard(text); err != nil {
+				warnOnce("react: copytext: " + err.Error())
+			}
+		}()
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SerializeCloneable converts v into a value that can be passed to structuredClone
+// consumers such as workers (postMessage), BroadcastChannel and custom elements.
+//
+// Structs are converted using the same tag and omitempty rules as SToMap (opts are
+// also honored). time.Time values become Dates and []byte values become Uint8Arrays.
+// Functions, channels, promises and DOM nodes can't be cloned, so an error
+// containing the path of the value is returned. If WithElideUncloneable is provided,
+// they are dropped instead (or become null inside slices).
+//
+// Example:
+//
+//  msg, err := react.SerializeCloneable(job)
+//  worker.Call("postMessage", msg)
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/Web_Workers_API/Structured_clone_algorithm
+func SerializeCloneable(v interface{}, opts ...Option) (interface{}, error) {
+	out, _, err := toCloneValue("", v, newOptions(opts))
+	return out, err
+}
+
+// uncloneable returns an error for a value that can't be cloned, or
+// nothing if the value should be dropped.
+func uncloneable(path, what string, opts *options) (interface{}, bool, error) {
+	if opts.elideUncloneable {
+		return nil, false, nil
+	}
+	return nil, false, pathError(path, "can't clone "+what)
+}
+
+// toCloneValue converts v. ok is false if v should be dropped.
+func toCloneValue(path string, v interface{}, opts *options) (_ interface{}, ok bool, _ error) {
+
+	if v == nil || jsObjectIsNil(v) {
+		return nil, true, nil
+	}
+
+	switch x := v.(type) {
+	case *js.Object:
+		return jsToCloneValue(path, x, opts)
+	case time.Time:
+		return js.Global.Get("Date").New(float64(x.UnixNano()) / float64(time.Millisecond)), true, nil
+	case []byte:
+		arr := js.Global.Get("Uint8Array").New(len(x))
+		for i, b := range x {
+			arr.SetIndex(i, b)
+		}
+		return arr, true, nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), true, nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true, nil
+	case reflect.String:
+		return rv.String(), true, nil
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil, true, nil
+		}
+		return toCloneValue(path, rv.Elem().Interface(), opts)
+	case reflect.Struct:
+		out := map[string]interface{}{}
+		if err := cloneFields(path, rv, out, opts); err != nil {
+			return nil, false, err
+		}
+		return out, true, nil
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, false, pathError(path, "map keys must be strings")
+		}
+		if rv.IsNil() {
+			return nil, true, nil
+		}
+		out := map[string]interface{}{}
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := iter.Key().String()
+			val, ok, err := toCloneValue(joinPath(path, k), iter.Value().Interface(), opts)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				out[k] = val
+			}
+		}
+		return out, true, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil, true, nil
+		}
+		out := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			val, _, err := toCloneValue(joinPath(path, strconv.Itoa(i)), rv.Index(i).Interface(), opts)
+			if err != nil {
+				return nil, false, err
+			}
+			out = append(out, val)
+		}
+		return out, true, nil
+	case reflect.Func:
+		return uncloneable(path, "function", opts)
+	}
+
+	return uncloneable(path, "value of kind "+rv.Kind().String(), opts)
+}
+
+// cloneFields converts the fields of the struct s and stores them in out.
+// Embedded structs are promoted in the same way as SToMap.
+func cloneFields(path string, s reflect.Value, out map[string]interface{}, opts *options) error {
+
+	promoted := map[string]interface{}{}
+
+	for _, fi := range cachedFields(s.Type(), opts) {
+
+		fieldValRaw := s.Field(fi.index)
+
+		if fi.embedded {
+			embedded := fieldValRaw
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					// The promoted fields have their zero values
+					embedded = reflect.Zero(embedded.Type().Elem())
+				} else {
+					embedded = embedded.Elem()
+				}
+			}
+
+			if err := cloneFields(path, embedded, promoted, opts); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fi.skip || (opts.omitEmpty(fi.omitEmpty) && fieldValRaw.IsZero()) {
+			// Omit field
+			continue
+		}
+
+		val, ok, err := toCloneValue(joinPath(path, fi.key), fieldValRaw.Interface(), opts)
+		if err != nil {
+			return err
+		}
+		if ok {
+			out[fi.key] = val
+		}
+	}
+
+	for attr, val := range promoted {
+		if _, exists := out[attr]; !exists {
+			out[attr] = val
+		}
+	}
+
+	return nil
+}
+
+// jsToCloneValue checks that a native javascript value can be cloned.
+func jsToCloneValue(path string, o *js.Object, opts *options) (interface{}, bool, error) {
+
+	if o == js.Undefined {
+		return o, true, nil
+	}
+
+	switch {
+	case o.Get("constructor") == js.Global.Get("Function"):
+		return uncloneable(path, "function", opts)
+	case o.Get("constructor") == js.Global.Get("Promise"):
+		return uncloneable(path, "promise", opts)
+	case js.Global.Get("Node") != js.Undefined && js.Global.Get("Node").Get("prototype").Call("isPrototypeOf", o).Bool():
+		return uncloneable(path, "DOM node", opts)
+	}
+
+	return o, true, nil
+}
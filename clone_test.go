@@ -0,0 +1,141 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test in Node.js 17 (or later).
+
+type cloneChild struct {
+	Name string `react:"name"`
+}
+
+type cloneProps struct {
+	cloneChild
+	Title    string                 `react:"title"`
+	Count    int64                  `react:"count"`
+	Ratio    float64                `react:"ratio"`
+	Empty    string                 `react:"empty,omitempty"`
+	Created  time.Time              `react:"created"`
+	Data     []byte                 `react:"data"`
+	Tags     []string               `react:"tags"`
+	Nil      *cloneChild            `react:"nil"`
+	Children []*cloneChild          `react:"children"`
+	Extra    map[string]interface{} `react:"extra"`
+	Object   *js.Object             `react:"object"`
+	Skipped  func()                 `react:"-"`
+}
+
+// structuredClone clones v using the native structuredClone. It returns an error if it throws.
+func structuredClone(t *testing.T, v interface{}) (_ *js.Object, rErr error) {
+	fn := js.Global.Get("structuredClone")
+	if fn == js.Undefined {
+		t.Skip("structuredClone is not available")
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = err
+		}
+	}()
+	return fn.Invoke(v), nil
+}
+
+func TestSerializeCloneableConformance(t *testing.T) {
+
+	object := js.Global.Get("Object").New()
+	object.Set("a", 1)
+
+	in := cloneProps{
+		cloneChild: cloneChild{Name: "name"},
+		Title:      "title",
+		Count:      1 << 40,
+		Ratio:      0.5,
+		Created:    time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Data:       []byte{1, 2, 3},
+		Tags:       []string{"a", "b"},
+		Children:   []*cloneChild{{Name: "c"}, nil},
+		Extra:      map[string]interface{}{"nested": map[string]interface{}{"n": 1}},
+		Object:     object,
+		Skipped:    func() {},
+	}
+
+	out, err := SerializeCloneable(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Everything that SerializeCloneable produces must be accepted by structuredClone
+	cloned, err := structuredClone(t, out)
+	if err != nil {
+		t.Fatalf("structuredClone threw: %v", err)
+	}
+
+	stringify := func(o interface{}) string {
+		return js.Global.Get("JSON").Call("stringify", o).String()
+	}
+	if a, b := stringify(out), stringify(cloned); a != b {
+		t.Errorf("clone mismatch:\n got: %s\nwant: %s", b, a)
+	}
+
+	if created := cloned.Get("created"); created.Get("constructor") != js.Global.Get("Date") ||
+		created.Call("getTime").Float() != js.Global.Get("Date").Call("UTC", 2020, 0, 2, 3, 4, 5).Float() {
+		t.Errorf("expected a Date, got: %v", cloned.Get("created"))
+	}
+	if data := cloned.Get("data"); data.Get("constructor") != js.Global.Get("Uint8Array") || data.Length() != 3 {
+		t.Errorf("expected a Uint8Array, got: %v", data)
+	}
+	if cloned.Get("count").Float() != 1<<40 || cloned.Get("name").String() != "name" {
+		t.Errorf("unexpected clone: %s", stringify(cloned))
+	}
+}
+
+func TestSerializeCloneableUncloneable(t *testing.T) {
+
+	type props struct {
+		Title string      `react:"title"`
+		Value interface{} `react:"value"`
+	}
+
+	// The values that structuredClone rejects are rejected (or elided)
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"go func", func() {}},
+		{"js function", js.Global.Get("Function").New("return 1")},
+		{"promise", js.Global.Get("Promise").Call("resolve", 1)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := structuredClone(t, map[string]interface{}{"value": tc.value}); err == nil {
+				t.Fatalf("expected structuredClone to throw")
+			}
+
+			if _, err := SerializeCloneable(props{Title: "title", Value: tc.value}); err == nil {
+				t.Errorf("expected an error")
+			}
+
+			out, err := SerializeCloneable(props{Title: "title", Value: tc.value}, WithElideUncloneable())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := structuredClone(t, out); err != nil {
+				t.Errorf("structuredClone threw for the elided value: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// UseTransition is a hook that wraps React's useTransition. State updates made inside
+// the function passed to startTransition are marked as non-urgent, so urgent updates
+// (eg. typing) can interrupt them. isPending is true while the transition is rendering.
+//
+// React 18+ is required. For older versions, startTransition calls the function
+// immediately and isPending is always false. When Development is true, a warning is printed to the console.
+//
+// Example:
+//
+//  isPending, startTransition := react.UseTransition()
+//
+//  startTransition(func() {
+//      setTab("photos")
+//  })
+//
+// See: https://reactjs.org/docs/hooks-reference.html#usetransition
+func UseTransition() (isPending bool, startTransition func(func())) {
+
+	if React.Get("useTransition") == js.Undefined {
+		warnOnce("react: useTransition is not available (React 18+ is required)")
+		return false, func(fn func()) {
+			fn()
+		}
+	}
+
+	res := React.Call("useTransition")
+	start := res.Index(1)
+	return res.Index(0).Bool(), func(fn func()) {
+		start.Invoke(fn)
+	}
+}
+
+// UseDeferredValue is a hook that wraps React's useDeferredValue. It returns value, but
+// during urgent updates it keeps returning the previous value until the urgent render has finished.
+// value is kept as a Go value. Values are compared using ==.
+//
+// React 18+ is required. For older versions, value is returned unchanged.
+// When Development is true, a warning is printed to the console.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#usedeferredvalue
+func UseDeferredValue(value interface{}) interface{} {
+
+	if React.Get("useDeferredValue") == js.Undefined {
+		warnOnce("react: useDeferredValue is not available (React 18+ is required)")
+		return value
+	}
+
+	type deferredState struct {
+		value interface{}
+		box   *js.Object
+	}
+
+	st := useGoRef(func() interface{} {
+		return &deferredState{value: value, box: wrapBox(value)}
+	}).(*deferredState)
+
+	// Keep the same box while the value is unchanged, so React can compare it
+	if !sameValue(st.value, value) {
+		st.value = value
+		st.box = wrapBox(value)
+	}
+
+	return unwrapBox(React.Call("useDeferredValue", st.box))
+}
@@ -0,0 +1,90 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// DefaultInputDebounce is the debounce interval used by ControlledInput when Debounce is 0.
+var DefaultInputDebounce = 300 * time.Millisecond
+
+// debounceTimerKey is the property of the DOM node that stores a pending timer.
+const debounceTimerKey = "__reactDebounceTimer"
+
+// ControlledInput is used as a struct field to keep an input responsive while
+// the Go state is updated on a delay. When the struct is converted by SToMap, the field
+// is replaced by the defaultValue, onChange and ref props of the input (the field's name is ignored).
+//
+// The DOM input keeps its own value while the user is typing. OnChange is called with
+// the latest value once the user has stopped typing for Debounce. When Value changes
+// (and the user is not typing), the DOM input is updated to match.
+//
+// Example:
+//
+//  type SearchProps struct {
+//      Type   string `react:"type"`
+//      Search react.ControlledInput
+//  }
+//
+//  react.JSX("input", &SearchProps{
+//      Type: "search",
+//      Search: react.ControlledInput{
+//          Value:    query,
+//          OnChange: func(val string) { setQuery(val) },
+//          Debounce: 250 * time.Millisecond,
+//      },
+//  })
+//
+// NOTE: The struct must not have other fields named defaultValue, onChange or ref.
+type ControlledInput struct {
+	Value    string
+	OnChange func(value string)
+
+	// Debounce is the delay before OnChange is called. The default is DefaultInputDebounce.
+	Debounce time.Duration
+}
+
+// props returns the props that wire the input.
+func (c ControlledInput) props() map[string]interface{} {
+
+	debounce := c.Debounce
+	if debounce == 0 {
+		debounce = DefaultInputDebounce
+	}
+
+	pending := func(node *js.Object) bool {
+		timer := node.Get(debounceTimerKey)
+		return timer != nil && timer != js.Undefined
+	}
+
+	return map[string]interface{}{
+		"defaultValue": c.Value,
+		"onChange": func(e *js.Object) {
+			node := e.Get("target")
+			if pending(node) {
+				js.Global.Call("clearTimeout", node.Get(debounceTimerKey))
+			}
+
+			node.Set(debounceTimerKey, js.Global.Call("setTimeout", func() {
+				node.Set(debounceTimerKey, js.Undefined)
+				if c.OnChange != nil {
+					c.OnChange(node.Get("value").String())
+				}
+			}, int64(debounce/time.Millisecond)))
+		},
+		"ref": func(node *js.Object) {
+			// A new ref function is created for every conversion,
+			// so React calls it after every render.
+			if node == nil || pending(node) || node == js.Global.Get("document").Get("activeElement") {
+				return
+			}
+
+			if node.Get("value").String() != c.Value {
+				node.Set("value", c.Value)
+			}
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// CSSProp returns name unchanged. When Development is true, a warning is
+// printed to the console if name is not a recognized CSS property.
+// It is useful for properties that are not known at compile time
+// (otherwise use the CSS constants, eg. CSSBackgroundColor).
+func CSSProp(name string) string {
+	if !Development {
+		return name
+	}
+
+	if _, exists := cssProperties[name]; !exists {
+		js.Global.Get("console").Call("warn", "react: unrecognized CSS property: "+name)
+	}
+
+	return name
+}
@@ -0,0 +1,31 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package css provides a typed struct for inline styles, so that typos in
+// property names are caught at compile time.
+//
+// Example:
+//
+//  type Props struct {
+//      Style *css.CSSProperties `react:"style,omitempty"`
+//  }
+//
+//  react.JSX("div", &Props{Style: &css.CSSProperties{BackgroundColor: "red", FontSize: "12px"}})
+//
+// To add properties, edit properties.txt and run go generate. It also updates
+// the CSS constants of the react package (eg. react.CSSBackgroundColor).
+package css
+
+import (
+	"github.com/rocketlaunchr/react"
+)
+
+//go:generate go run gen.go
+
+// ToMap converts the properties into the same map that SToMap produces.
+// Empty properties are omitted.
+func (p *CSSProperties) ToMap() map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+	return react.SToMap(p)
+}
@@ -0,0 +1,105 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build ignore
+// +build ignore
+
+// gen generates properties.go (and the CSS constants of the react
+// package in ../css_properties.go) from properties.txt.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+
+	names := readProperties("properties.txt")
+
+	// The fields of CSSProperties
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package css\n\n")
+	buf.WriteString("// CSSProperties represents the inline styles of an element.\n")
+	buf.WriteString("// Each field is a CSS property in camelCase. Empty fields are omitted.\n")
+	buf.WriteString("type CSSProperties struct {\n")
+	for _, name := range names {
+		buf.WriteString("\t" + exported(name) + " string `react:\"" + name + ",omitempty\"`\n")
+	}
+	buf.WriteString("}\n")
+	write("properties.go", buf.Bytes())
+
+	// The constants (and the set used by CSSProp) of the react package
+	buf.Reset()
+	buf.WriteString("// Code generated by css/gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package react\n\n")
+	buf.WriteString(`// The CSS constants contain the names of CSS properties in the camelCase form used by
+// React's style prop. Using them instead of string literals prevents typos.
+//
+// Example:
+//
+//  style := js.M{react.CSSBackgroundColor: "red", react.CSSFontSize: "12px"}
+//
+// Linters can additionally be configured to flag string literal keys in style maps
+// so that the constants (or CSSProp) are always used.
+//
+// See: https://reactjs.org/docs/dom-elements.html#style
+`)
+	buf.WriteString("const (\n")
+	for _, name := range names {
+		buf.WriteString("\tCSS" + exported(name) + " = \"" + name + "\"\n")
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// cssProperties is the set of all properties in CSS.\n")
+	buf.WriteString("var cssProperties = map[string]struct{}{\n")
+	for _, name := range names {
+		buf.WriteString("\t\"" + name + "\": {},\n")
+	}
+	buf.WriteString("}\n")
+	write("../css_properties.go", buf.Bytes())
+}
+
+// readProperties returns the property names in file, skipping blank lines and comments.
+func readProperties(file string) []string {
+
+	f, err := os.Open(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	names := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+		names = append(names, name)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return names
+}
+
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// write formats src and writes it to file.
+func write(file string, src []byte) {
+
+	src, err := format.Source(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(file, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
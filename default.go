@@ -0,0 +1,70 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strings"
+)
+
+// applyDefaults sets the fields of strct that have a "default" tag and
+// whose keys are missing from mp.
+func applyDefaults(mp map[string]interface{}, strct interface{}) error {
+
+	s, isNil := indirect(reflect.ValueOf(strct))
+	if isNil || s.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return applyFieldDefaults(mp, s)
+}
+
+func applyFieldDefaults(mp map[string]interface{}, s reflect.Value) error {
+
+	for _, fi := range cachedFields(s.Type(), defaultOptions) {
+
+		if fi.embedded {
+			// The fields of embedded structs are promoted
+			embedded, isNil := indirect(s.Field(fi.index))
+			if isNil {
+				continue
+			}
+			if err := applyFieldDefaults(mp, embedded); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !fi.hasDefault || fi.skip || hasKey(mp, fi.key) {
+			continue
+		}
+
+		field := s.Field(fi.index)
+
+		vals := []string{fi.def}
+		if t := field.Type(); t.Kind() == reflect.Slice || (t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Slice) {
+			vals = strings.Split(fi.def, ",")
+		}
+
+		v, ok := decodeQueryValue(vals, field.Type())
+		if !ok {
+			return &ConversionError{Kind: field.Kind(), Path: fi.name, Msg: "invalid default " + `"` + fi.def + `"`}
+		}
+		field.Set(v)
+	}
+	return nil
+}
+
+// hasKey returns true if key is in mp. Just like mapstructure, a
+// case-insensitive match is used if there is no exact match.
+func hasKey(mp map[string]interface{}, key string) bool {
+	if _, exists := mp[key]; exists {
+		return true
+	}
+	for k := range mp {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SetDisplayName sets the name of component that is shown in React DevTools
+// (and in React's warnings).
+//
+// NOTE: Class components are named by NewClassDef.
+//
+// See: https://reactjs.org/docs/react-component.html#displayname
+func SetDisplayName(component *js.Object, name string) {
+	component.Set("displayName", name)
+}
+
+// NamedComponent converts fn into a functional component named name (see SetDisplayName).
+// fn can be a *js.Object (which is named and returned), a
+// func(props *js.Object) *js.Object or a func(this *js.Object, arguments []*js.Object) interface{}.
+//
+// Example:
+//
+//  var Greeting = react.NamedComponent("Greeting", func(props *js.Object) *js.Object {
+//      return elements.H1(nil, "Hello "+props.Get("name").String())
+//  })
+//
+func NamedComponent(name string, fn interface{}) *js.Object {
+
+	var component *js.Object
+
+	switch x := fn.(type) {
+	case *js.Object:
+		component = x
+	case func(this *js.Object, arguments []*js.Object) interface{}:
+		component = js.MakeFunc(x)
+	case func(props *js.Object) *js.Object:
+		component = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			return x(arguments[0])
+		})
+	default:
+		panic("react: NamedComponent: unsupported component type")
+	}
+
+	SetDisplayName(component, name)
+	return component
+}
@@ -0,0 +1,66 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type enumValues struct {
+	valid map[string]struct{}
+	order []string // for the error message
+}
+
+// enums stores the valid values of the types registered with RegisterEnum.
+var enums = map[reflect.Type]*enumValues{}
+
+// RegisterEnum registers the valid values of the named string type T. When UnmarshalStruct
+// (and UnmarshalProps etc.) decodes a value into a field of type T, an error is returned if the
+// value is not valid. The error names the field and the value. It should be called during
+// initialization (eg. in an init function). Registering T again replaces its valid values.
+//
+// Example:
+//
+//  type Variant string
+//
+//  const (
+//      Primary   Variant = "primary"
+//      Secondary Variant = "secondary"
+//  )
+//
+//  func init() {
+//      react.RegisterEnum(Primary, Secondary)
+//  }
+//
+func RegisterEnum[T ~string](valid ...T) {
+	ev := &enumValues{valid: map[string]struct{}{}}
+	for _, v := range valid {
+		if _, exists := ev.valid[string(v)]; !exists {
+			ev.valid[string(v)] = struct{}{}
+			ev.order = append(ev.order, strconv.Quote(string(v)))
+		}
+	}
+	enums[reflect.TypeOf((*T)(nil)).Elem()] = ev
+}
+
+// enumDecodeHook is mapstructure's DecodeHook. It rejects strings that are not valid
+// values of the registered type they are decoded into.
+func enumDecodeHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if len(enums) == 0 || from.Kind() != reflect.String {
+		return data, nil
+	}
+
+	ev, exists := enums[to]
+	if !exists {
+		return data, nil
+	}
+
+	s := reflect.ValueOf(data).String()
+	if _, valid := ev.valid[s]; !valid {
+		return nil, errors.New("invalid value " + strconv.Quote(s) + " for " + to.String() + " (must be one of " + strings.Join(ev.order, ", ") + ")")
+	}
+	return data, nil
+}
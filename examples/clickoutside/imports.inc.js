@@ -0,0 +1,4 @@
+
+window.React = require('react')
+window.ReactDOM = require('react-dom')
+window.createReactClass = require('create-react-class')
\ No newline at end of file
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react"
+	"github.com/rocketlaunchr/react/elements"
+)
+
+func main() {
+	domTarget := react.GetElementByID("app")
+
+	// An example of a dropdown that closes when the user clicks outside it,
+	// presses Escape or tabs away from it
+	dropdownComponent := func(props *js.Object) *js.Object {
+
+		open, setOpen := react.UseReducerTyped(func(_ bool, open bool) bool { return open }, false)
+		ref := react.React.Call("useRef", nil)
+
+		react.UseClickOutside(ref, func(e *js.Object) {
+			setOpen(false)
+		})
+
+		var menu *js.Object
+		if open {
+			menu = elements.Ul(nil,
+				elements.Li(nil, elements.Button(nil, "Edit")),
+				elements.Li(nil, elements.Button(nil, "Duplicate")),
+				elements.Li(nil, elements.Button(nil, "Delete")),
+			)
+		}
+
+		return react.JSX("div", map[string]interface{}{
+			"ref": ref,
+			"onKeyDown": func(e *js.Object) {
+				if (&react.SyntheticEvent{O: e}).Key() == "Escape" {
+					setOpen(false)
+				}
+			},
+			"onBlur": func(e *js.Object) {
+				// Focus has moved to an element outside the dropdown
+				next := (&react.SyntheticEvent{O: e}).RelatedTarget()
+				if next == nil || !ref.Get("current").Call("contains", next).Bool() {
+					setOpen(false)
+				}
+			},
+		},
+			elements.Button(&elements.ButtonProps{OnClick: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+				setOpen(!open)
+				return nil
+			})}, "Actions ▾"),
+			menu,
+		)
+	}
+
+	react.Render(react.JSX(dropdownComponent, nil), domTarget)
+}
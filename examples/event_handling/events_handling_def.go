@@ -24,7 +24,7 @@ func init() {
 
 	eventsDef := react.NewClassDef("Events")
 
-	eventsDef["slider"] = react.CreateRef()
+	eventsDef["slider"] = react.CreateRefObject()
 
 	eventsDef.GetInitialState(func(this *js.Object, props react.Map) interface{} {
 		return eventsState{Counter: &[]int{0}[0], SliderValue: 0}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react"
+	"github.com/rocketlaunchr/react/elements"
+)
+
+type action int
+
+const (
+	increment action = iota
+	decrement
+	reset
+)
+
+func reducer(count int, a action) int {
+	switch a {
+	case increment:
+		return count + 1
+	case decrement:
+		return count - 1
+	case reset:
+		return 0
+	}
+	return count
+}
+
+func main() {
+	domTarget := react.GetElementByID("app")
+
+	// An example using a Functional Component with the useReducer hook
+	counterComponent := func(props *js.Object) *js.Object {
+
+		count, dispatch := react.UseReducerTyped(reducer, 0)
+
+		return elements.Div(nil,
+			elements.H3(&elements.H3Props{Style: &elements.Styles{TextAlign: "center"}}, "Count: "+strconv.Itoa(count)),
+			elements.Button(&elements.ButtonProps{OnClick: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+				dispatch(increment)
+				return nil
+			})}, "+"),
+			elements.Button(&elements.ButtonProps{OnClick: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+				dispatch(decrement)
+				return nil
+			})}, "-"),
+			elements.Button(&elements.ButtonProps{OnClick: js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+				dispatch(reset)
+				return nil
+			})}, "Reset"),
+		)
+	}
+
+	react.Render(react.JSX(counterComponent, nil), domTarget)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react"
+	"github.com/rocketlaunchr/react/elements"
+)
+
+// EditorComponent is a react component.
+var EditorComponent *js.Object
+
+// EditorState is the state for EditorComponent.
+type EditorState struct {
+	HTML string `react:"html"`
+}
+
+func init() {
+
+	editorDef := react.NewClassDef("Editor")
+
+	editorDef["editor"] = react.CreateRefObject()
+
+	editorDef.GetInitialState(func(this *js.Object, props react.Map) interface{} {
+		return EditorState{HTML: "Type here..."}
+	})
+
+	editorDef.SetEventHandler("input", func(this *js.Object, e *react.SyntheticEvent, props, state react.Map, setState react.SetState) {
+
+		// Record the caret before the re-render replaces the editor's contents.
+		sel, err := react.GetSelection(this.Get("editor"))
+
+		html := e.CurrentTarget().Get("innerHTML").String()
+
+		setState(EditorState{HTML: html}, func() {
+			if err != nil {
+				return
+			}
+
+			// The dom has been updated, so put the caret back where it was.
+			if err := react.RestoreSelection(this.Get("editor"), sel); err != nil {
+				if _, ok := err.(*react.NodeNotFoundError); ok {
+					// The node that held the caret was removed by the re-render.
+					js.Global.Get("console").Call("warn", "caret not restored:", err.Error())
+					return
+				}
+				js.Global.Get("console").Call("error", err.Error())
+			}
+		})
+	})
+
+	editorDef.Render(func(this *js.Object, props, state react.Map) interface{} {
+
+		var eState EditorState
+		react.UnmarshalState(this, &eState)
+
+		words := len(strings.Fields(stripTags(eState.HTML)))
+
+		return elements.Div(nil,
+			react.JSX("div", js.M{
+				"ref":                     this.Get("editor"),
+				"contentEditable":         true,
+				"onInput":                 this.Get("input"),
+				"dangerouslySetInnerHTML": js.M{"__html": eState.HTML},
+				"style":                   js.M{"border": "1px solid #ccc", "padding": "8px"},
+			}),
+			elements.P(nil, "Words: "+strconv.Itoa(words)),
+		)
+	})
+
+	EditorComponent = react.CreateClass(editorDef)
+}
+
+// stripTags removes html tags.
+func stripTags(html string) string {
+	return js.Global.Get("String").Invoke(html).Call("replace", js.Global.Get("RegExp").New("<[^>]*>", "g"), " ").String()
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/rocketlaunchr/react"
+)
+
+func main() {
+	domTarget := react.GetElementByID("app")
+
+	react.Render(react.JSX(EditorComponent, nil), domTarget)
+}
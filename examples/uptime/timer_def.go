@@ -41,7 +41,8 @@ func init() {
 
 	timerDef.ComponentDidMount(func(this *js.Object, props, state react.Map, setState react.SetState) {
 		// Create a js timer that continually calls this.tick()
-		this.Set("timer", react.JSFn("setInterval", this.Get("tick"), 1000))
+		timer, _ := react.JSFn("setInterval", this.Get("tick"), 1000)
+		this.Set("timer", timer)
 	})
 
 	timerDef.SetMethod("tick", func(this *js.Object, props, state react.Map, setState react.SetState, arguments []*js.Object) interface{} {
@@ -0,0 +1,143 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fieldInfo is the metadata of an exported struct field that is used by
+// convertFields. It only depends on the field's type and tag, so it is
+// computed once per struct type.
+type fieldInfo struct {
+	index    int
+	name     string // Go field name
+	tag      string
+	tagName  string
+	tagOpts  tagOptions
+	key      string // key in the output map
+	skip     bool   // tag is "-"
+	embedded bool   // fields are promoted to the parent
+
+	omitEmpty               bool // tag contains omitempty
+	inline                  bool
+	role                    string
+	selector                string
+	variant                 string
+	sanitize                bool
+	promise                 bool
+	media                   bool
+	hotkey                  string
+	loading                 bool
+	copyText                bool
+	track                   string
+	isKey                   bool   // also emitted as the "key" prop
+	autofocus               string // "on" or "mount"
+	dangerouslySetInnerHTML bool
+
+	def        string // default tag
+	hasDefault bool
+}
+
+type fieldCacheKey struct {
+	t         reflect.Type
+	tagNames  string
+	camelCase bool
+}
+
+// fieldCache stores []fieldInfo keyed by fieldCacheKey.
+var fieldCache sync.Map
+
+// cachedFields returns the metadata of the exported fields of struct type t.
+func cachedFields(t reflect.Type, opts *options) []fieldInfo {
+
+	key := fieldCacheKey{t, opts.tagNamesKey(), opts.camelCase}
+
+	if fields, exists := fieldCache.Load(key); exists {
+		return fields.([]fieldInfo)
+	}
+
+	fields := []fieldInfo{}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if f.PkgPath != "" && !f.Anonymous {
+			// not exported
+			continue
+		}
+
+		fieldTag := opts.tag(f)
+		tagName, tagOpts := parseTag(fieldTag)
+		key, squash := fieldKey(f, fieldTag, opts.camelCase)
+
+		fi := fieldInfo{
+			index:     i,
+			name:      f.Name,
+			tag:       fieldTag,
+			tagName:   tagName,
+			tagOpts:   tagOpts,
+			key:       key,
+			skip:      fieldTag == "-",
+			omitEmpty: tagOpts.has("omitempty"),
+			inline:    tagOpts.has("inline") || squash,
+		}
+
+		if squash && f.Anonymous && tagName == "" {
+			// The fields are promoted (even if the tag has options)
+			fi.embedded = true
+			fi.inline = false
+		}
+
+		if f.PkgPath != "" && !fi.embedded {
+			// Just like encoding/json, the exported fields of an unexported
+			// embedded struct are promoted, but nothing else unexported is kept
+			continue
+		}
+
+		fi.role, _ = tagOpts.value("role")
+		fi.selector, _ = tagOpts.value("selector")
+		fi.variant, _ = tagOpts.value("variant")
+		fi.sanitize = tagOpts.has("sanitize")
+		fi.promise = tagOpts.has("promise")
+		fi.media = tagOpts.has("media")
+		fi.hotkey, _ = tagOpts.value("hotkey")
+		fi.loading = tagOpts.has("loading")
+		fi.copyText = tagOpts.has("copytext")
+		fi.track, _ = tagOpts.value("track")
+		fi.isKey = tagOpts.has("key")
+		if tagOpts.has("autofocus") {
+			fi.autofocus = "on"
+		} else if mode, _ := tagOpts.value("autofocus"); mode == "mount" {
+			fi.autofocus = mode
+		}
+		fi.dangerouslySetInnerHTML = f.Name == "DangerouslySetInnerHTML" && tagName == "dangerouslySetInnerHTML"
+		fi.def, fi.hasDefault = f.Tag.Lookup("default")
+
+		fields = append(fields, fi)
+	}
+
+	fieldCache.Store(key, fields)
+	return fields
+}
+
+// tagNamesKey identifies the tag names for fieldCache.
+func (o *options) tagNamesKey() string {
+	if len(o.tagNames) == 1 {
+		return o.tagNames[0]
+	}
+	return strings.Join(o.tagNames, ",")
+}
+
+// lowerFirst lowercases the first rune of s.
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToLower(r)) + s[size:]
+}
@@ -0,0 +1,298 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react/forks/mapstructure"
+)
+
+// FlagExposure is provided to OnExposure when a component reads a flag.
+type FlagExposure struct {
+	Flag    string
+	Variant interface{} // the value of the flag
+
+	// Component is the displayName (or name) of the component that read the flag.
+	// It is only available in development builds of React.
+	Component string
+}
+
+// FeatureFlagsOptions configures NewFeatureFlags.
+type FeatureFlagsOptions struct {
+	// Initial are the flags that are available immediately.
+	Initial map[string]interface{}
+
+	// Loader is called from a goroutine to load the flags (eg. from a server).
+	// The flags it returns are merged into Initial.
+	Loader func() (map[string]interface{}, error)
+
+	// OnExposure is called the first time each component reads each variant of a flag.
+	OnExposure func(FlagExposure)
+
+	// OnError is called if Loader returns an error.
+	OnError func(error)
+}
+
+// FeatureFlags stores flags that are provided to components by FeatureFlagsProvider.
+type FeatureFlags struct {
+	flags       map[string]interface{}
+	loaded      bool
+	opts        FeatureFlagsOptions
+	exposed     map[FlagExposure]bool
+	subscribers map[int]func()
+	nextSubID   int
+}
+
+// NewFeatureFlags creates a FeatureFlags. If a Loader is provided, it is called immediately.
+func NewFeatureFlags(opts FeatureFlagsOptions) *FeatureFlags {
+	f := &FeatureFlags{
+		flags:       map[string]interface{}{},
+		loaded:      opts.Loader == nil,
+		opts:        opts,
+		exposed:     map[FlagExposure]bool{},
+		subscribers: map[int]func(){},
+	}
+
+	for name, val := range opts.Initial {
+		f.flags[name] = val
+	}
+
+	if opts.Loader != nil {
+		go func() {
+			flags, err := opts.Loader()
+			if err != nil {
+				if opts.OnError != nil {
+					opts.OnError(err)
+				}
+				flags = nil
+			}
+			f.loaded = true
+			f.Update(flags)
+		}()
+	}
+
+	return f
+}
+
+// Get returns the value of a flag.
+func (f *FeatureFlags) Get(name string) (_ interface{}, exists bool) {
+	val, exists := f.flags[name]
+	return val, exists
+}
+
+// Set changes the value of a flag and re-renders the components that use it.
+func (f *FeatureFlags) Set(name string, value interface{}) {
+	f.Update(map[string]interface{}{name: value})
+}
+
+// Update changes the values of multiple flags and re-renders the components that use them.
+func (f *FeatureFlags) Update(flags map[string]interface{}) {
+	for name, val := range flags {
+		f.flags[name] = val
+	}
+	for _, fn := range f.subscribers {
+		fn()
+	}
+}
+
+// lookup returns the value of a flag. A warning is printed once for each unknown flag
+// after the flags have been loaded.
+func (f *FeatureFlags) lookup(name string) (interface{}, bool) {
+	val, exists := f.flags[name]
+	if !exists && f.loaded {
+		warnOnce("react: unknown feature flag " + name)
+	}
+	return val, exists
+}
+
+// expose calls OnExposure once for each flag, variant and component.
+func (f *FeatureFlags) expose(name string, variant interface{}) {
+	if f.opts.OnExposure == nil {
+		return
+	}
+
+	e := FlagExposure{Flag: name, Variant: variant, Component: currentComponentName()}
+	if f.exposed[e] {
+		return
+	}
+	f.exposed[e] = true
+	f.opts.OnExposure(e)
+}
+
+// currentComponentName returns the name of the component that is rendering.
+// React only tracks it in development builds.
+func currentComponentName() string {
+	internals := React.Get("__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED")
+	if internals == js.Undefined || internals.Get("ReactCurrentOwner") == js.Undefined {
+		return ""
+	}
+
+	owner := internals.Get("ReactCurrentOwner").Get("current")
+	if owner == nil || owner == js.Undefined {
+		return ""
+	}
+
+	typ := owner.Get("type")
+	if typ == nil || typ == js.Undefined {
+		return ""
+	}
+	return componentName(typ)
+}
+
+// featureFlagsContext is created when FeatureFlagsProvider is first used.
+var featureFlagsContext *js.Object
+
+func getFeatureFlagsContext() *js.Object {
+	if featureFlagsContext == nil {
+		featureFlagsContext = React.Call("createContext", nil)
+	}
+	return featureFlagsContext
+}
+
+// FeatureFlagsProvider provides flags to UseFlag, UseFlags and Flag in its children.
+//
+// Example:
+//
+//  flags := react.NewFeatureFlags(react.FeatureFlagsOptions{
+//      Initial: map[string]interface{}{"newCheckout": false},
+//      Loader: func() (map[string]interface{}, error) {
+//          return fetchFlags(userID)
+//      },
+//      OnExposure: func(e react.FlagExposure) {
+//          analytics.Track("exposure", e.Flag, e.Variant, e.Component)
+//      },
+//  })
+//
+//  react.FeatureFlagsProvider(flags, react.JSX(App, nil))
+//
+func FeatureFlagsProvider(flags *FeatureFlags, children ...interface{}) interface{} {
+	return JSX(getFeatureFlagsContext().Get("Provider"), map[string]interface{}{"value": wrapBox(flags)}, children...)
+}
+
+// useFeatureFlags returns the flags provided by the nearest FeatureFlagsProvider
+// and re-renders the component when they change.
+func useFeatureFlags() *FeatureFlags {
+
+	var flags *FeatureFlags
+	v := React.Call("useContext", getFeatureFlagsContext())
+	if v != nil && v != js.Undefined {
+		flags = unwrapBox(v).(*FeatureFlags)
+	}
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		if flags == nil {
+			return nil
+		}
+
+		version := 0
+		flags.nextSubID++
+		id := flags.nextSubID
+		flags.subscribers[id] = func() {
+			version++
+			setVersion(version)
+		}
+		return func() {
+			delete(flags.subscribers, id)
+		}
+	}, []interface{}{v})
+
+	return flags
+}
+
+// UseFlag is a hook that returns the value of a boolean flag. def is returned if there
+// is no FeatureFlagsProvider or the flag is unknown (or not a bool).
+// The component re-renders when the flags are updated.
+//
+// Example:
+//
+//  if react.UseFlag("newCheckout", false) {
+//      return react.JSX(NewCheckout, nil)
+//  }
+//
+func UseFlag(name string, def bool) bool {
+
+	flags := useFeatureFlags()
+	if flags == nil {
+		return def
+	}
+
+	val, exists := flags.lookup(name)
+	on, ok := val.(bool)
+	if !exists || !ok {
+		return def
+	}
+
+	flags.expose(name, on)
+	return on
+}
+
+// UseFlags is a hook that decodes the flags into dest, which must be a pointer to a struct.
+// The "react" struct tag names the flags. Fields of unknown flags keep the values already in
+// dest, which act as defaults. Flags can be any type (eg. a string for the variant of an experiment).
+//
+// Example:
+//
+//  type Flags struct {
+//      NewCheckout bool   `react:"newCheckout"`
+//      ButtonColor string `react:"buttonColor"`
+//  }
+//
+//  flags := Flags{ButtonColor: "blue"}
+//  react.UseFlags(&flags)
+//
+func UseFlags(dest interface{}) error {
+
+	flags := useFeatureFlags()
+	if flags == nil {
+		return nil
+	}
+
+	mp := map[string]interface{}{}
+	for _, fi := range cachedFields(reflect.TypeOf(dest).Elem(), defaultOptions) {
+		if fi.skip || fi.embedded {
+			continue
+		}
+		if val, exists := flags.lookup(fi.key); exists {
+			mp[fi.key] = val
+			flags.expose(fi.key, val)
+		}
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:  "react",
+		FieldKey: reactFieldKey,
+		Result:   dest,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(mp)
+}
+
+// Flag returns an element that renders whenOn if the boolean flag is on, otherwise whenOff.
+// whenOn and whenOff can be elements, strings or nil.
+//
+// Example:
+//
+//  react.Flag("newHeader", react.JSX(NewHeader, nil), react.JSX(Header, nil))
+//
+func Flag(name string, whenOn, whenOff interface{}) *js.Object {
+	return JSX(flagComponent, map[string]interface{}{
+		"name":    name,
+		"whenOn":  wrapBox(whenOn),
+		"whenOff": wrapBox(whenOff),
+	})
+}
+
+var flagComponent = NamedComponent("Flag", func(this *js.Object, arguments []*js.Object) interface{} {
+	props := arguments[0]
+
+	if UseFlag(props.Get("name").String(), false) {
+		return unwrapBox(props.Get("whenOn"))
+	}
+	return unwrapBox(props.Get("whenOff"))
+})
@@ -0,0 +1,213 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// focusableSelector matches the elements that can be focusable.
+const focusableSelector = "a[href], area[href], button, input, select, textarea, iframe, summary, [tabindex], [contenteditable]"
+
+// GetFocusOrder returns the elements inside root (excluding root) in the order that they
+// are focused when the Tab key is pressed. Elements with a positive tabindex come first
+// (in ascending order of tabindex), followed by the other focusable elements in DOM order.
+// Elements that are disabled, hidden (eg. inside an element with the hidden or inert attribute)
+// or have a negative tabindex are left out. Only the checked radio button of a group is
+// included (or the first if none are checked).
+//
+// See: https://www.w3.org/WAI/WCAG21/Understanding/focus-order.html
+func GetFocusOrder(root *js.Object) []*js.Object {
+	if root == nil || root == js.Undefined {
+		return nil
+	}
+
+	type focusable struct {
+		el       *js.Object
+		tabIndex int
+		pos      int // DOM order
+	}
+
+	candidates := []focusable{}
+	radios := map[string]int{} // radio group => index in candidates
+
+	nodes := root.Call("querySelectorAll", focusableSelector)
+	for i := 0; i < nodes.Length(); i++ {
+		el := nodes.Index(i)
+
+		tabIndex, ok := focusTabIndex(el)
+		if !ok || tabIndex < 0 || !isFocusable(el, root) {
+			continue
+		}
+
+		if name, isRadio := radioGroup(el); isRadio {
+			if idx, exists := radios[name]; exists {
+				// The checked radio button replaces the first
+				if el.Get("checked").Bool() && !candidates[idx].el.Get("checked").Bool() {
+					candidates[idx].el = el
+				}
+				continue
+			}
+			radios[name] = len(candidates)
+		}
+
+		candidates = append(candidates, focusable{el, tabIndex, i})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].tabIndex, candidates[j].tabIndex
+		if a > 0 && b > 0 {
+			return a < b
+		}
+		return a > 0 && b == 0
+	})
+
+	out := make([]*js.Object, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.el
+	}
+	return out
+}
+
+// focusTabIndex returns the tabindex of el. ok is false if el is not focusable
+// (eg. an anchor without href that doesn't have a tabindex).
+func focusTabIndex(el *js.Object) (tabIndex int, ok bool) {
+
+	if v, exists := attribute(el, "tabindex"); exists {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err == nil {
+			return n, true
+		}
+	}
+
+	switch strings.ToLower(el.Get("tagName").String()) {
+	case "a", "area":
+		_, ok = attribute(el, "href")
+		return 0, ok
+	case "button", "select", "textarea", "iframe", "summary":
+		return 0, true
+	case "input":
+		typ, _ := attribute(el, "type")
+		return 0, strings.ToLower(typ) != "hidden"
+	}
+
+	if v, exists := attribute(el, "contenteditable"); exists && v != "false" {
+		return 0, true
+	}
+	return 0, false
+}
+
+// isFocusable returns false if el is disabled or it (or an ancestor below root) is hidden.
+func isFocusable(el *js.Object, root *js.Object) bool {
+
+	switch strings.ToLower(el.Get("tagName").String()) {
+	case "button", "input", "select", "textarea":
+		if _, disabled := attribute(el, "disabled"); disabled {
+			return false
+		}
+		// Controls inside a disabled fieldset are disabled
+		if fieldset := el.Call("closest", "fieldset[disabled]"); fieldset != nil && fieldset != js.Undefined {
+			return false
+		}
+	}
+
+	for n := el; n != nil && n != js.Undefined && n != root; n = n.Get("parentElement") {
+		if _, hidden := attribute(n, "hidden"); hidden {
+			return false
+		}
+		if _, inert := attribute(n, "inert"); inert {
+			return false
+		}
+		if style := n.Get("style"); style != nil && style != js.Undefined {
+			if style.Get("display").String() == "none" || style.Get("visibility").String() == "hidden" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// radioGroup returns the name of the group of a radio button.
+func radioGroup(el *js.Object) (string, bool) {
+	if strings.ToLower(el.Get("tagName").String()) != "input" {
+		return "", false
+	}
+	if typ, _ := attribute(el, "type"); strings.ToLower(typ) != "radio" {
+		return "", false
+	}
+
+	name, _ := attribute(el, "name")
+	if name == "" {
+		// Unnamed radio buttons are not grouped
+		return "", false
+	}
+	return name, true
+}
+
+// focusLabel returns the accessible name of el (or its text).
+func focusLabel(el *js.Object) string {
+	if name, _ := accessibleName(el, elementRole(el)); name != "" {
+		return name
+	}
+	return visibleText(el)
+}
+
+// AssertFocusOrder checks that the labels (ie. accessible names) of the elements returned by
+// GetFocusOrder are expectedLabels. If they aren't, an error is returned and, if t is a
+// *testing.T (or anything with an Errorf method), the test is marked as failed.
+//
+// Example:
+//
+//  func TestLoginFocusOrder(t *testing.T) {
+//      react.AssertFocusOrder(t, container, []string{"Email", "Password", "Sign in"})
+//  }
+//
+func AssertFocusOrder(t interface{}, root *js.Object, expectedLabels []string) error {
+
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	order := GetFocusOrder(root)
+	labels := make([]string, len(order))
+	for i, el := range order {
+		labels[i] = focusLabel(el)
+	}
+
+	if stringsEqual(labels, expectedLabels) {
+		return nil
+	}
+
+	err := errors.New("react: unexpected focus order: got " + quoteStrings(labels) + ", expected " + quoteStrings(expectedLabels))
+	if e, ok := t.(interface {
+		Errorf(format string, args ...interface{})
+	}); ok {
+		e.Errorf("%s", err.Error())
+	}
+	return err
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func quoteStrings(s []string) string {
+	quoted := make([]string, len(s))
+	for i := range s {
+		quoted[i] = strconv.Quote(s[i])
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
@@ -95,6 +95,11 @@ type DecoderConfig struct {
 	// The tag name that mapstructure reads for field names. This
 	// defaults to "mapstructure"
 	TagName string
+
+	// FieldKey, if set, is used instead of TagName to determine the key
+	// of a struct field in the map and whether the fields of a struct field
+	// are squashed into the parent. A key of "-" skips the field.
+	FieldKey func(field reflect.StructField) (key string, squash bool)
 }
 
 // A Decoder takes a raw interface value and turns it into structured
@@ -681,25 +686,17 @@ func (d *Decoder) decodeMapFromStruct(name string, dataVal reflect.Value, val re
 			return errorf("cannot assign type '%s' to map value field of type '%s'", v.Type(), valMap.Type().Elem())
 		}
 
-		tagValue := f.Tag.Get(d.config.TagName)
-		tagParts := strings.Split(tagValue, ",")
-
 		// Determine the name of the key in the map
-		keyName := f.Name
-		if tagParts[0] != "" {
-			if tagParts[0] == "-" {
-				continue
-			}
-			keyName = tagParts[0]
+		keyName, squash := d.fieldKey(f)
+		if keyName == "-" {
+			continue
 		}
 
-		// If "squash" is specified in the tag, we squash the field down.
-		squash := false
-		for _, tag := range tagParts[1:] {
-			if tag == "squash" {
-				squash = true
-				break
+		if squash && v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct {
+			if v.IsNil() {
+				continue
 			}
+			v = v.Elem()
 		}
 		if squash && v.Kind() != reflect.Struct {
 			return errorf("cannot squash non-struct type '%s'", v.Type())
@@ -1021,13 +1018,19 @@ func (d *Decoder) decodeStructFromMap(name string, dataVal, val reflect.Value) e
 			fieldKind := fieldType.Type.Kind()
 
 			// If "squash" is specified in the tag, we squash the field down.
-			squash := false
-			tagParts := strings.Split(fieldType.Tag.Get(d.config.TagName), ",")
-			for _, tag := range tagParts[1:] {
-				if tag == "squash" {
-					squash = true
-					break
+			_, squash := d.fieldKey(fieldType)
+
+			if squash && fieldKind == reflect.Ptr && fieldType.Type.Elem().Kind() == reflect.Struct {
+				fieldVal := structVal.Field(i)
+				if fieldVal.IsNil() {
+					if !fieldVal.CanSet() || !d.hasStructKeys(dataVal, fieldType.Type.Elem()) {
+						// Leave the pointer nil
+						continue
+					}
+					fieldVal.Set(reflect.New(fieldType.Type.Elem()))
 				}
+				structs = append(structs, fieldVal.Elem())
+				continue
 			}
 
 			if squash {
@@ -1048,12 +1051,10 @@ func (d *Decoder) decodeStructFromMap(name string, dataVal, val reflect.Value) e
 	// for fieldType, field := range fields {
 	for _, f := range fields {
 		field, fieldValue := f.field, f.val
-		fieldName := field.Name
 
-		tagValue := field.Tag.Get(d.config.TagName)
-		tagValue = strings.SplitN(tagValue, ",", 2)[0]
-		if tagValue != "" {
-			fieldName = tagValue
+		fieldName, _ := d.fieldKey(field)
+		if fieldName == "-" {
+			continue
 		}
 
 		rawMapKey := reflect.ValueOf(fieldName)
@@ -1137,6 +1138,54 @@ func (d *Decoder) decodeStructFromMap(name string, dataVal, val reflect.Value) e
 	return nil
 }
 
+// hasStructKeys returns true if dataVal contains a key for any field of struct type t.
+func (d *Decoder) hasStructKeys(dataVal reflect.Value, t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		fieldName, _ := d.fieldKey(t.Field(i))
+
+		for _, dataValKey := range dataVal.MapKeys() {
+			if mK, ok := dataValKey.Interface().(string); ok && strings.EqualFold(mK, fieldName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldKey returns the key of field in the map and whether its fields are squashed
+// into the parent. It uses config.FieldKey if it is set.
+func (d *Decoder) fieldKey(field reflect.StructField) (string, bool) {
+	if d.config.FieldKey != nil {
+		return d.config.FieldKey(field)
+	}
+
+	tagParts := strings.Split(field.Tag.Get(d.config.TagName), ",")
+
+	key := field.Name
+	if tagParts[0] != "" {
+		key = tagParts[0]
+	}
+
+	fieldKind := field.Type.Kind()
+	squash := false
+	for _, tag := range tagParts[1:] {
+		// "inline" maps can't be reversed, so only structs are squashed
+		if tag == "squash" || (tag == "inline" && fieldKind == reflect.Struct) {
+			squash = true
+			break
+		}
+	}
+
+	// Untagged embedded structs are also squashed, matching how their
+	// fields are promoted by encoding/json (and react.SToMap).
+	if field.Anonymous && tagParts[0] == "" {
+		if fieldKind == reflect.Struct || (fieldKind == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct) {
+			squash = true
+		}
+	}
+	return key, squash
+}
+
 func getKind(val reflect.Value) reflect.Kind {
 	kind := val.Kind()
 
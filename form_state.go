@@ -0,0 +1,572 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// FormErrors is returned by FormState.Validate (and Submit) when fields are invalid.
+// The keys are the names of the fields.
+type FormErrors map[string]error
+
+// Error implements the error interface.
+func (e FormErrors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "react: invalid form fields: " + strings.Join(names, ", ")
+}
+
+// FormState stores the values of a form outside of React's state, so that editing a field
+// only re-renders the components that use that field (see UseField). The values are
+// converted into the struct T (using UnmarshalStruct's rules) when the form is submitted.
+//
+// The fields are named by the react tags of T's fields. A field is validated when it loses
+// focus and all the fields are validated when the form is submitted. A field is invalid if it
+// has the "required" tag option and is empty, it can't be converted to the type of the struct
+// field, or it is not a valid value of a type registered with RegisterEnum.
+//
+// Example:
+//
+//  type Signup struct {
+//      Email string `react:"email,required"`
+//      Age   int    `react:"age"`
+//  }
+//
+//  // In the form's component
+//  form := react.UseFormState(Signup{})
+//  form.UseUnsavedChangesPrompt("You have unsaved changes")
+//
+//  return elements.Form(&elements.FormProps{OnSubmit: form.OnSubmit(func(s Signup) error {
+//      return save(s)
+//  })},
+//      react.JSX(EmailField, map[string]interface{}{"form": form}),
+//      ...
+//  )
+//
+//  // In EmailField
+//  email := form.UseField("email")
+//  return elements.Input(email.InputProps())
+//
+type FormState[T any] struct {
+	initial map[string]interface{}
+	values  map[string]interface{}
+	errors  FormErrors
+
+	// submitErr is the error of the last submission made by OnSubmit.
+	submitErr error
+
+	// fieldSubscribers are notified when the value or error of a field changes.
+	fieldSubscribers map[string]map[int]func()
+	// formSubscribers are notified when the form becomes (or stops being) dirty.
+	formSubscribers map[int]func()
+	nextSubID       int
+
+	// box is used as the dependency of the effects of the hooks.
+	box *js.Object
+}
+
+// NewFormState returns a FormState whose initial values are initial.
+func NewFormState[T any](initial T) *FormState[T] {
+	f := &FormState[T]{
+		initial:          SToMap(initial),
+		errors:           FormErrors{},
+		fieldSubscribers: map[string]map[int]func(){},
+		formSubscribers:  map[int]func(){},
+	}
+	f.values = copyMap(f.initial)
+	f.box = wrapBox(f)
+	return f
+}
+
+// UseFormState is a hook that returns a FormState that persists for the lifetime of the
+// component. initial is only used on the first render. The component doesn't re-render
+// when the values change.
+func UseFormState[T any](initial T) *FormState[T] {
+	return useGoRef(func() interface{} {
+		return NewFormState(initial)
+	}).(*FormState[T])
+}
+
+// Value returns the current value of the field name.
+func (f *FormState[T]) Value(name string) interface{} {
+	return f.values[name]
+}
+
+// SetValue sets the value of the field name. Only the components that use the field are re-rendered.
+func (f *FormState[T]) SetValue(name string, value interface{}) {
+	if old, exists := f.values[name]; exists && valuesEqual(old, value) {
+		return
+	}
+
+	wasDirty := f.IsDirty()
+	f.values[name] = value
+
+	BatchedUpdates(func() {
+		f.notifyField(name)
+		if wasDirty != f.IsDirty() {
+			f.notifyForm()
+		}
+	})
+}
+
+// Error returns the error of the field name from the last time it was validated.
+func (f *FormState[T]) Error(name string) error {
+	return f.errors[name]
+}
+
+// Errors returns the errors of the fields from the last time they were validated.
+func (f *FormState[T]) Errors() FormErrors {
+	out := make(FormErrors, len(f.errors))
+	for name, err := range f.errors {
+		out[name] = err
+	}
+	return out
+}
+
+// IsFieldDirty returns true if the value of the field name differs from its initial value.
+func (f *FormState[T]) IsFieldDirty(name string) bool {
+	a, b := f.initial[name], f.values[name]
+	if formZero(a) && formZero(b) {
+		return false
+	}
+	return !valuesEqual(a, b)
+}
+
+// IsDirty returns true if any field differs from its initial value.
+func (f *FormState[T]) IsDirty() bool {
+	return len(f.DirtyFields()) > 0
+}
+
+// DirtyFields returns the (sorted) names of the fields that differ from their initial values.
+func (f *FormState[T]) DirtyFields() []string {
+	names := []string{}
+	for name := range f.values {
+		if f.IsFieldDirty(name) {
+			names = append(names, name)
+		}
+	}
+	for name := range f.initial {
+		if _, exists := f.values[name]; !exists && f.IsFieldDirty(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Reset sets the fields back to their initial values and clears the errors.
+func (f *FormState[T]) Reset() {
+	wasDirty := f.IsDirty()
+
+	changed := []string{}
+	for name := range f.values {
+		if f.IsFieldDirty(name) || f.errors[name] != nil {
+			changed = append(changed, name)
+		}
+	}
+	for name := range f.errors {
+		if _, exists := f.values[name]; !exists {
+			changed = append(changed, name)
+		}
+	}
+
+	f.values = copyMap(f.initial)
+	f.errors = FormErrors{}
+
+	BatchedUpdates(func() {
+		for _, name := range changed {
+			f.notifyField(name)
+		}
+		if wasDirty {
+			f.notifyForm()
+		}
+	})
+}
+
+// ValidateField validates the field name. The error (if any) is returned by
+// Error and UseField until the field is validated again.
+func (f *FormState[T]) ValidateField(name string) error {
+	_, err := f.fieldValue(name)
+	f.setError(name, err)
+	return err
+}
+
+// Validate validates all the fields and converts the values into T.
+// A FormErrors is returned if any field is invalid.
+func (f *FormState[T]) Validate() (T, error) {
+
+	var out T
+
+	errs := FormErrors{}
+	mp := map[string]interface{}{}
+
+	for _, name := range formFieldNames(formStructType[T]()) {
+		v, err := f.fieldValue(name)
+		if err != nil {
+			errs[name] = err
+		} else if v != nil {
+			mp[name] = v
+		}
+	}
+
+	BatchedUpdates(func() {
+		for name := range f.errors {
+			if errs[name] == nil {
+				f.setError(name, nil)
+			}
+		}
+		for name, err := range errs {
+			f.setError(name, err)
+		}
+	})
+
+	if len(errs) > 0 {
+		return out, errs
+	}
+
+	if err := unmarshalStruct(mp, &out, false); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// Submit validates the form and calls fn with the values. If fn succeeds, the
+// values become the initial values (so the form is no longer dirty).
+func (f *FormState[T]) Submit(fn func(values T) error) error {
+
+	values, err := f.Validate()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(values); err != nil {
+		return err
+	}
+
+	dirty := f.DirtyFields()
+	f.initial = copyMap(f.values)
+
+	BatchedUpdates(func() {
+		for _, name := range dirty {
+			f.notifyField(name)
+		}
+		if len(dirty) > 0 {
+			f.notifyForm()
+		}
+	})
+	return nil
+}
+
+// OnSubmit returns an onSubmit handler for a form element. It prevents the browser from
+// submitting the form and calls Submit in a goroutine (so fn can block).
+//
+// If Submit returns an error (FormErrors if the form is invalid, or the error returned
+// by fn), onError is called with it. The error of the last submission is also available
+// from SubmitError.
+func (f *FormState[T]) OnSubmit(fn func(values T) error, onError ...func(err error)) func(e *js.Object) {
+	return func(e *js.Object) {
+		e.Call("preventDefault")
+		go func() {
+			err := f.Submit(fn)
+			f.submitErr = err
+			if err != nil && len(onError) > 0 && onError[0] != nil {
+				onError[0](err)
+			}
+		}()
+	}
+}
+
+// SubmitError returns the error of the last submission made by the handler
+// returned by OnSubmit. It is nil if the submission succeeded.
+func (f *FormState[T]) SubmitError() error {
+	return f.submitErr
+}
+
+// FieldBinding is returned by FormState.UseField.
+type FieldBinding struct {
+	Name  string
+	Value interface{}
+	Error error
+	Dirty bool
+
+	// OnChange sets the value of the field. It accepts the value or a change event
+	// (the value, or checked for checkboxes, of the event's target is used).
+	OnChange func(value interface{})
+
+	// OnBlur validates the field.
+	OnBlur func()
+}
+
+// InputProps returns the name, value (or checked for boolean values), onChange
+// and onBlur props of an input element.
+func (b FieldBinding) InputProps() map[string]interface{} {
+	props := map[string]interface{}{
+		"name":     b.Name,
+		"onChange": b.OnChange,
+		"onBlur":   b.OnBlur,
+	}
+
+	switch v := b.Value.(type) {
+	case bool:
+		props["checked"] = v
+	case nil:
+		// Keep the input controlled
+		props["value"] = ""
+	default:
+		props["value"] = v
+	}
+	return props
+}
+
+// UseField is a hook that returns the binding of the field name. The component
+// re-renders when the field's value or error changes.
+//
+// The value, onChange handler and error of the field are binding.Value, binding.OnChange
+// and binding.Error. A struct is returned (rather than the 3 values) so that the field's
+// dirty state, its onBlur handler (which validates it) and InputProps are also available.
+func (f *FormState[T]) UseField(name string) FieldBinding {
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		version := 0
+		f.nextSubID++
+		id := f.nextSubID
+
+		if f.fieldSubscribers[name] == nil {
+			f.fieldSubscribers[name] = map[int]func(){}
+		}
+		f.fieldSubscribers[name][id] = func() {
+			version++
+			setVersion(version)
+		}
+		return func() {
+			delete(f.fieldSubscribers[name], id)
+		}
+	}, []interface{}{f.box, name})
+
+	return FieldBinding{
+		Name:  name,
+		Value: f.values[name],
+		Error: f.errors[name],
+		Dirty: f.IsFieldDirty(name),
+		OnChange: func(value interface{}) {
+			f.SetValue(name, formEventValue(value))
+		},
+		OnBlur: func() {
+			f.ValidateField(name)
+		},
+	}
+}
+
+// UseDirty is a hook that returns true if the form is dirty (see IsDirty). The
+// component only re-renders when the form becomes (or stops being) dirty.
+func (f *FormState[T]) UseDirty() bool {
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		version := 0
+		f.nextSubID++
+		id := f.nextSubID
+		f.formSubscribers[id] = func() {
+			version++
+			setVersion(version)
+		}
+		return func() {
+			delete(f.formSubscribers, id)
+		}
+	}, []interface{}{f.box})
+
+	return f.IsDirty()
+}
+
+// UseUnsavedChangesPrompt is a hook that asks the user to confirm leaving the page (using
+// the beforeunload event) while the form is dirty. Most browsers show their own message
+// instead of message.
+func (f *FormState[T]) UseUnsavedChangesPrompt(message string) {
+
+	useEffect(func() func() {
+		if js.Global.Get("addEventListener") == js.Undefined {
+			return nil
+		}
+
+		listener := func(e *js.Object) {
+			if f.IsDirty() {
+				e.Call("preventDefault")
+				e.Set("returnValue", message)
+			}
+		}
+		js.Global.Call("addEventListener", "beforeunload", listener)
+		return func() {
+			js.Global.Call("removeEventListener", "beforeunload", listener)
+		}
+	}, []interface{}{f.box, message})
+}
+
+func (f *FormState[T]) notifyField(name string) {
+	for _, fn := range f.fieldSubscribers[name] {
+		fn()
+	}
+}
+
+func (f *FormState[T]) notifyForm() {
+	for _, fn := range f.formSubscribers {
+		fn()
+	}
+}
+
+func (f *FormState[T]) setError(name string, err error) {
+	old := f.errors[name]
+
+	if err == nil {
+		delete(f.errors, name)
+	} else {
+		f.errors[name] = err
+	}
+
+	// Only re-render if the message changed
+	if (old == nil) != (err == nil) || (old != nil && old.Error() != err.Error()) {
+		f.notifyField(name)
+	}
+}
+
+// fieldValue returns the value of the field name converted to the type of its struct
+// field. An error is returned if the field is invalid.
+func (f *FormState[T]) fieldValue(name string) (interface{}, error) {
+
+	t := formStructType[T]()
+	if t == nil {
+		return f.values[name], nil
+	}
+
+	ft, required, exists := formField(t, name)
+	if !exists {
+		return f.values[name], nil
+	}
+
+	v := f.values[name]
+
+	// Inputs provide strings
+	if s, ok := v.(string); ok && indirectType(ft).Kind() != reflect.String {
+		if strings.TrimSpace(s) == "" {
+			v = nil
+		} else {
+			conv, ok := decodeQueryValue([]string{strings.TrimSpace(s)}, ft)
+			if !ok {
+				return nil, errors.New("invalid value " + strconv.Quote(s))
+			}
+			v = conv.Interface()
+		}
+	}
+
+	if required && formZero(v) {
+		return nil, &MissingPropsError{Fields: []string{name}}
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	// Check that the value can be decoded (eg. it is a valid value of a registered enum)
+	dst := reflect.New(t)
+	if err := decodeStruct(map[string]interface{}{name: v}, dst.Interface(), false); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// formStructType returns the struct type of T (or nil if T is not a struct).
+func formStructType[T any]() reflect.Type {
+	t := indirectType(reflect.TypeOf((*T)(nil)).Elem())
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// formField returns the type of the field of struct type t named name (by the react tag).
+// The fields of embedded structs are included.
+func formField(t reflect.Type, name string) (_ reflect.Type, required bool, exists bool) {
+	for _, fi := range cachedFields(t, defaultOptions) {
+		if fi.skip {
+			continue
+		}
+		if fi.embedded {
+			if ft, required, exists := formField(indirectType(t.Field(fi.index).Type), name); exists {
+				return ft, required, true
+			}
+			continue
+		}
+		if fi.key == name {
+			return t.Field(fi.index).Type, fi.tagOpts.has("required"), true
+		}
+	}
+	return nil, false, false
+}
+
+// formFieldNames returns the names of the fields of struct type t (including
+// the fields of embedded structs).
+func formFieldNames(t reflect.Type) []string {
+	if t == nil {
+		return nil
+	}
+
+	names := []string{}
+	for _, fi := range cachedFields(t, defaultOptions) {
+		switch {
+		case fi.skip:
+		case fi.embedded:
+			names = append(names, formFieldNames(indirectType(t.Field(fi.index).Type))...)
+		default:
+			names = append(names, fi.key)
+		}
+	}
+	return names
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// formZero returns true if v is empty.
+func formZero(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if o, ok := v.(*js.Object); ok {
+		return o == nil || o == js.Undefined
+	}
+	return reflect.ValueOf(v).IsZero()
+}
+
+// formEventValue returns the value of the target of a change event. Other values are
+// returned as is.
+func formEventValue(v interface{}) interface{} {
+	e, ok := v.(*js.Object)
+	if !ok || e == nil || e == js.Undefined {
+		return v
+	}
+
+	target := e.Get("target")
+	if target == nil || target == js.Undefined {
+		return v
+	}
+
+	if t := target.Get("type"); t != js.Undefined && t.String() == "checkbox" {
+		return target.Get("checked").Bool()
+	}
+	return target.Get("value").String()
+}
@@ -1,3 +1,3 @@
 module github.com/rocketlaunchr/react
 
-go 1.10
\ No newline at end of file
+go 1.18
\ No newline at end of file
@@ -0,0 +1,402 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react/forks/mapstructure"
+)
+
+// GraphQLCacheTTL is how long the results of UseQuery are cached.
+// Set it to 0 to disable caching.
+var GraphQLCacheTTL = 60 * time.Second
+
+// GraphQLError is returned when the response of a GraphQL request contains errors.
+type GraphQLError struct {
+	Messages []string
+
+	// O contains the errors array of the response.
+	O *js.Object
+}
+
+// Error implements the error interface.
+func (e *GraphQLError) Error() string {
+	return "react: graphql: " + strings.Join(e.Messages, "; ")
+}
+
+// graphQLClient is provided by GraphQLProvider.
+type graphQLClient struct {
+	url     string
+	headers map[string]string
+}
+
+// graphQLContext is created when GraphQLProvider is first used.
+var graphQLContext *js.Object
+
+func getGraphQLContext() *js.Object {
+	if graphQLContext == nil {
+		graphQLContext = React.Call("createContext", nil)
+	}
+	return graphQLContext
+}
+
+// GraphQLProvider provides the GraphQL endpoint used by UseQuery and UseMutation
+// to its children.
+//
+// Example:
+//
+//  react.GraphQLProvider("/graphql", map[string]string{"Authorization": "Bearer " + token},
+//      react.JSX(App, nil),
+//  )
+//
+func GraphQLProvider(url string, headers map[string]string, children ...interface{}) interface{} {
+	client := &graphQLClient{url: url, headers: headers}
+	return JSX(getGraphQLContext().Get("Provider"), map[string]interface{}{"value": wrapBox(client)}, children...)
+}
+
+// useGraphQLClient returns the client provided by the nearest GraphQLProvider.
+func useGraphQLClient() *graphQLClient {
+	v := React.Call("useContext", getGraphQLContext())
+	if v == nil || v == js.Undefined {
+		return nil
+	}
+	return unwrapBox(v).(*graphQLClient)
+}
+
+// errNoGraphQLProvider is returned when a hook is not inside a GraphQLProvider.
+var errNoGraphQLProvider = errors.New("react: GraphQLProvider not found")
+
+// graphQLRequest sends a query or mutation and returns the data of the response.
+// It must be called from a goroutine.
+func graphQLRequest(client *graphQLClient, query string, variables interface{}) (*js.Object, error) {
+
+	body, err := JSONMarshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headers := js.M{"Content-Type": "application/json"}
+	for k, v := range client.headers {
+		headers[k] = v
+	}
+
+	resp, err := JSFnPromise("fetch", client.url, js.M{
+		"method":  "POST",
+		"headers": headers,
+		"body":    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Get("ok").Bool() {
+		return nil, errors.New("react: graphql request failed with status " + strconv.Itoa(resp.Get("status").Int()))
+	}
+
+	res, err := Await(resp.Call("json"))
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := res.Get("errors"); errs != nil && errs != js.Undefined && errs.Length() > 0 {
+		gErr := &GraphQLError{O: errs}
+		for i := 0; i < errs.Length(); i++ {
+			gErr.Messages = append(gErr.Messages, errs.Index(i).Get("message").String())
+		}
+		return nil, gErr
+	}
+
+	return res.Get("data"), nil
+}
+
+// decodeGraphQLData decodes the data of a response into result,
+// which must be a pointer. The "react" struct tag is used to name fields.
+func decodeGraphQLData(data *js.Object, result interface{}) error {
+	if data == nil || data == js.Undefined {
+		return nil
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ZeroFields: true,
+		TagName:    "react",
+		FieldKey:   reactFieldKey,
+		Result:     result,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return decoder.Decode(data.Interface())
+}
+
+type graphQLCacheEntry struct {
+	data      *js.Object
+	operation string
+	expires   time.Time
+}
+
+var (
+	// graphQLCache stores query results keyed by url, query and variables.
+	graphQLCache = map[string]graphQLCacheEntry{}
+
+	// graphQLSubscribers are notified when queries are invalidated.
+	graphQLSubscribers = map[int]func(operations []string){}
+	nextGraphQLSubID   int
+)
+
+var operationNameRegexp = regexp.MustCompile(`^\s*(?:query|mutation|subscription)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+
+// operationName returns the name of the operation in query (eg. "GetUser" for "query GetUser { ... }").
+func operationName(query string) string {
+	if m := operationNameRegexp.FindStringSubmatch(query); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// invalidateGraphQLQueries removes cached queries that match operations (either the
+// operation name or the full query) and refetches them.
+func invalidateGraphQLQueries(operations []string) {
+	for key, entry := range graphQLCache {
+		for _, op := range operations {
+			if op == entry.operation || strings.HasPrefix(key, op+"\x00") {
+				delete(graphQLCache, key)
+			}
+		}
+	}
+
+	for _, fn := range graphQLSubscribers {
+		fn(operations)
+	}
+}
+
+// QueryResult is returned by UseQuery.
+type QueryResult[T any] struct {
+	Data    T
+	Loading bool
+	Error   error
+
+	// Refetch sends the query again, bypassing the cache.
+	Refetch func()
+}
+
+type queryState[T any] struct {
+	result  QueryResult[T]
+	key     string
+	version int
+}
+
+// UseQuery is a hook that sends a GraphQL query to the endpoint provided by the nearest
+// GraphQLProvider. The data of the response is decoded into T using the "react" struct tag.
+// The query is sent again when query or variables change. Results are cached for GraphQLCacheTTL.
+//
+// Example:
+//
+//  type UserData struct {
+//      User struct {
+//          Name string `react:"name"`
+//      } `react:"user"`
+//  }
+//
+//  res := react.UseQuery[UserData](`query GetUser($id: ID!) { user(id: $id) { name } }`, map[string]interface{}{"id": id})
+//  if res.Loading {
+//      return elements.P(nil, "Loading...")
+//  }
+//
+func UseQuery[T any](query string, variables interface{}) QueryResult[T] {
+
+	client := useGraphQLClient()
+
+	st := useGoRef(func() interface{} {
+		return &queryState[T]{result: QueryResult[T]{Loading: true}}
+	}).(*queryState[T])
+
+	_, setVersion := useState(0)
+	rerender := func() {
+		st.version++
+		setVersion(st.version)
+	}
+
+	varsJSON, err := JSONMarshal(variables)
+	if err != nil {
+		st.result.Loading = false
+		st.result.Error = err
+	}
+
+	url := ""
+	if client != nil {
+		url = client.url
+	}
+	key := query + "\x00" + varsJSON + "\x00" + url
+
+	useEffect(func() func() {
+		if client == nil {
+			st.result.Loading = false
+			st.result.Error = errNoGraphQLProvider
+			rerender()
+			return nil
+		}
+
+		cancelled := false
+
+		fetch := func(force bool) {
+			if entry, exists := graphQLCache[key]; exists && !force && time.Now().Before(entry.expires) {
+				var data T
+				err := decodeGraphQLData(entry.data, &data)
+				st.result = QueryResult[T]{Data: data, Error: err, Refetch: st.result.Refetch}
+				rerender()
+				return
+			}
+
+			st.result.Loading = true
+			rerender()
+
+			go func() {
+				res, err := graphQLRequest(client, query, variables)
+				if cancelled {
+					return
+				}
+
+				var data T
+				if err == nil {
+					if GraphQLCacheTTL > 0 {
+						graphQLCache[key] = graphQLCacheEntry{data: res, operation: operationName(query), expires: time.Now().Add(GraphQLCacheTTL)}
+					}
+					err = decodeGraphQLData(res, &data)
+				}
+
+				st.result = QueryResult[T]{Data: data, Error: err, Refetch: st.result.Refetch}
+				rerender()
+			}()
+		}
+
+		st.key = key
+		st.result.Refetch = func() {
+			if !cancelled {
+				fetch(true)
+			}
+		}
+		fetch(false)
+
+		nextGraphQLSubID++
+		id := nextGraphQLSubID
+		name := operationName(query)
+		graphQLSubscribers[id] = func(operations []string) {
+			for _, op := range operations {
+				if op == query || (name != "" && op == name) {
+					fetch(true)
+					return
+				}
+			}
+		}
+
+		return func() {
+			cancelled = true
+			delete(graphQLSubscribers, id)
+		}
+	}, []interface{}{key})
+
+	if st.key != key && st.key != "" {
+		// The query or variables have changed, but the effect hasn't run yet
+		return QueryResult[T]{Loading: true, Refetch: func() {}}
+	}
+
+	res := st.result
+	if res.Refetch == nil {
+		res.Refetch = func() {}
+	}
+	return res
+}
+
+// MutationOptions configures UseMutation.
+type MutationOptions struct {
+	// RefetchQueries are the queries (either the operation name or the full query)
+	// that are removed from the cache and sent again after the mutation succeeds.
+	RefetchQueries []string
+}
+
+// UseMutation is a hook that returns a function that sends mutation to the endpoint
+// provided by the nearest GraphQLProvider. The input is sent as the variables of the
+// mutation (structs are converted using SToMap) and the data of the response is decoded
+// into TOutput using the "react" struct tag. isPending is true while a mutation is in progress.
+//
+// NOTE: The returned function blocks until the response is received, so it must be called from a goroutine.
+//
+// Example:
+//
+//  addTodo, isPending := react.UseMutation[AddTodoInput, AddTodoData](`mutation AddTodo($text: String!) { addTodo(text: $text) { id } }`,
+//      react.MutationOptions{RefetchQueries: []string{"GetTodos"}})
+//
+//  onClick := func(e *react.SyntheticEvent) {
+//      go func() {
+//          data, err := addTodo(AddTodoInput{Text: text})
+//      }()
+//  }
+//
+func UseMutation[TInput, TOutput any](mutation string, opts ...MutationOptions) (func(TInput) (TOutput, error), bool) {
+
+	client := useGraphQLClient()
+
+	type mutationState struct {
+		pending int // number of mutations in progress
+		version int
+	}
+
+	st := useGoRef(func() interface{} {
+		return &mutationState{}
+	}).(*mutationState)
+
+	_, setVersion := useState(0)
+	rerender := func() {
+		st.version++
+		setVersion(st.version)
+	}
+
+	var o MutationOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	trigger := func(input TInput) (TOutput, error) {
+		var data TOutput
+
+		if client == nil {
+			return data, errNoGraphQLProvider
+		}
+
+		var variables interface{} = input
+		if isStruct(input) {
+			variables = SToMap(input)
+		}
+
+		st.pending++
+		rerender()
+
+		res, err := graphQLRequest(client, mutation, variables)
+
+		st.pending--
+		rerender()
+
+		if err != nil {
+			return data, err
+		}
+
+		if len(o.RefetchQueries) > 0 {
+			invalidateGraphQLQueries(o.RefetchQueries)
+		}
+
+		err = decodeGraphQLData(res, &data)
+		return data, err
+	}
+
+	return trigger, st.pending > 0
+}
@@ -3,6 +3,9 @@
 package react
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/gopherjs/gopherjs/js"
 )
 
@@ -36,6 +39,52 @@ func Profiler(id string, onRender OnRenderCallback, children ...interface{}) *js
 	return JSX(React.Get("Profiler"), props, children...)
 }
 
+// Lazy lets you defer loading a component's code until it is rendered for the first time.
+// loader is called from a goroutine, so it can block (eg. using Await). It can return the
+// component itself or a module with the component as the default export.
+// If loader returns an error, the component fails to load and the nearest Error Boundary catches it.
+//
+// The component must be rendered inside a Suspense element.
+//
+// Example:
+//
+//  Chart := react.Lazy(func() (*js.Object, error) {
+//      return react.Await(js.Global.Call("import", "./chart.js"))
+//  })
+//
+//  react.Suspense(elements.Div(nil, "Loading..."), react.JSX(Chart, nil))
+//
+// See: https://reactjs.org/docs/code-splitting.html#reactlazy
+func Lazy(loader func() (*js.Object, error)) *js.Object {
+	return React.Call("lazy", func() *js.Object {
+		return js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+			go func() {
+				component, err := loader()
+				if err != nil {
+					reject.Invoke(jsError(err))
+					return
+				}
+
+				if component != nil && component.Get("default") != js.Undefined {
+					resolve.Invoke(component)
+				} else {
+					resolve.Invoke(js.M{"default": component})
+				}
+			}()
+		})
+	})
+}
+
+// Suspense displays fallback until its children have finished loading.
+//
+// See: https://reactjs.org/docs/code-splitting.html#reactlazy
+func Suspense(fallback interface{}, children ...interface{}) *js.Object {
+	props := map[string]interface{}{
+		"fallback": fallback,
+	}
+	return JSX(React.Get("Suspense"), props, children...)
+}
+
 // JSX is used to create an Element.
 func JSX(component interface{}, props interface{}, children ...interface{}) *js.Object {
 
@@ -50,8 +99,30 @@ func JSX(component interface{}, props interface{}, children ...interface{}) *js.
 	return React.Call("createElement", args...)
 }
 
+// ErrUndefinedPath is matched (using errors.Is) by the *UndefinedPathError
+// returned by JSFn and JSCall.
+var ErrUndefinedPath = errors.New("react: undefined path")
+
+// UndefinedPathError is returned by JSFn and JSCall when part of a path is undefined or null.
+type UndefinedPathError struct {
+	Path    string
+	Segment string // the first segment that is undefined or null
+}
+
+// Error implements the error interface.
+func (e *UndefinedPathError) Error() string {
+	return "react: " + e.Path + ": " + e.Segment + " is undefined"
+}
+
+// Is returns true if target is ErrUndefinedPath.
+func (e *UndefinedPathError) Is(target error) bool {
+	return target == ErrUndefinedPath
+}
+
 // JSFn is a convenience function used to call javascript native functions.
-// If the native function throws an exception, then a *js.Error is returned.
+// funcName can be an arbitrarily deep dotted path. If any part of the path is undefined,
+// then an *UndefinedPathError is returned. If the native function throws an exception,
+// then a *js.Error is returned. Struct arguments are converted using SToMap.
 //
 // Example:
 //
@@ -61,6 +132,9 @@ func JSX(component interface{}, props interface{}, children ...interface{}) *js.
 //  // JSON.parse('{"name":"John"}')
 //  JSFn("JSON.parse", `{"name":"John"}`)
 //
+//  // window.navigator.clipboard.writeText('Hello')
+//  JSFn("window.navigator.clipboard.writeText", "Hello")
+//
 func JSFn(funcName string, args ...interface{}) (_ *js.Object, rErr error) {
 	defer func() {
 		if e := recover(); e != nil {
@@ -74,30 +148,105 @@ func JSFn(funcName string, args ...interface{}) (_ *js.Object, rErr error) {
 
 	out := js.Global
 
-	splits := js.Global.Get("String").Invoke(funcName).Call("split", ".")
-	for idx := 0; idx < splits.Length(); idx++ {
-		split := splits.Index(idx).String()
-		if idx == splits.Length()-1 {
-			out = out.Call(split, args...)
-		} else {
-			out = out.Get(split)
+	splits := strings.Split(funcName, ".")
+	for idx, split := range splits {
+		if idx == len(splits)-1 {
+			return jsCall(out, funcName, split, args)
+		}
+
+		out = out.Get(split)
+		if out == nil || out == js.Undefined {
+			return nil, &UndefinedPathError{Path: funcName, Segment: split}
 		}
 	}
 
 	return out, nil
 }
 
-// CreateRef will create a Ref.
+// JSCall calls a method of obj with this bound to obj. If obj is nil or
+// the method is undefined, then an *UndefinedPathError is returned.
+// If the method throws an exception, then a *js.Error is returned.
+// Struct arguments are converted using SToMap.
+//
+// Example:
+//
+//  // element.scrollIntoView({behavior: 'smooth'})
+//  JSCall(element, "scrollIntoView", map[string]interface{}{"behavior": "smooth"})
+//
+//  // localStorage.setItem('key', 'value')
+//  JSCall(js.Global.Get("localStorage"), "setItem", "key", "value")
+//
+func JSCall(obj *js.Object, method string, args ...interface{}) (_ *js.Object, rErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = err
+		}
+	}()
+
+	if obj == nil || obj == js.Undefined {
+		return nil, &UndefinedPathError{Path: method, Segment: method}
+	}
+
+	return jsCall(obj, method, method, args)
+}
+
+// jsCall calls method on obj after converting struct arguments.
+func jsCall(obj *js.Object, path string, method string, args []interface{}) (*js.Object, error) {
+	fn := obj.Get(method)
+	if fn == nil || fn == js.Undefined {
+		return nil, &UndefinedPathError{Path: path, Segment: method}
+	}
+
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		if _, ok := arg.(*js.Object); !ok && isStruct(arg) {
+			converted[i] = SToMap(arg)
+		} else {
+			converted[i] = arg
+		}
+	}
+
+	return obj.Call(method, converted...), nil
+}
+
+// CreateRefObject will create a Ref and return the underlying javascript object.
+// See CreateRef for a typed version.
 //
 // See: https://reactjs.org/docs/refs-and-the-dom.html
-func CreateRef() *js.Object {
+func CreateRefObject() *js.Object {
 	return React.Call("createRef")
 }
 
 // ForwardRef will forward a Ref to child components.
 //
+// component can also be a render function with the signature
+// func(props map[string]interface{}, ref *js.Object) *js.Object.
+// It receives the props and the ref, and is responsible for applying the ref
+// to the element it returns. This allows a Go functional component to expose a
+// DOM node (or an imperative handle) to its parent. Only the top level of the props
+// is converted: strings, numbers and booleans become Go values while other values
+// (eg. children) are left as *js.Object.
+//
+// Example:
+//
+//  FancyInput := react.ForwardRef(func(props map[string]interface{}, ref *js.Object) *js.Object {
+//      return elements.Input(&elements.InputProps{Ref: ref, Placeholder: props["placeholder"].(string)})
+//  })
+//
+//  react.JSX(FancyInput, map[string]interface{}{"ref": inputRef, "placeholder": "Name"})
+//
 // See: https://reactjs.org/docs/forwarding-refs.html
 func ForwardRef(component interface{}) *js.Object {
+	if render, ok := component.(func(props map[string]interface{}, ref *js.Object) *js.Object); ok {
+		return React.Call("forwardRef", func(props *js.Object, ref *js.Object) *js.Object {
+			return render(propsMap(props), ref)
+		})
+	}
+
 	return React.Call("forwardRef", func(props *js.Object, ref *js.Object) *js.Object {
 		props.Set("ref", ref)
 
@@ -117,6 +266,44 @@ func ForwardRef(component interface{}) *js.Object {
 	})
 }
 
+// propsMap converts the top level of props to a map. Values that are not strings,
+// numbers or booleans are left untouched so that React elements stay intact.
+func propsMap(props *js.Object) map[string]interface{} {
+	out := map[string]interface{}{}
+	if props == nil || props == js.Undefined || jsTypeOf(props) != "object" {
+		return out
+	}
+
+	keys := js.Global.Get("Object").Call("keys", props)
+	for i := 0; i < keys.Length(); i++ {
+		k := keys.Index(i).String()
+		v := props.Get(k)
+
+		if v == nil || v == js.Undefined {
+			out[k] = nil
+			continue
+		}
+
+		switch jsTypeOf(v) {
+		case "string", "number", "boolean":
+			out[k] = v.Interface()
+		default:
+			out[k] = v
+		}
+	}
+	return out
+}
+
+var typeOf *js.Object
+
+// jsTypeOf returns the result of the typeof operator.
+func jsTypeOf(o *js.Object) string {
+	if typeOf == nil {
+		typeOf = js.Global.Get("Function").New("v", "return typeof v")
+	}
+	return typeOf.Invoke(o).String()
+}
+
 // CreateContext is used when you want to pass data to a deeply
 // embedded child component without using props.
 //
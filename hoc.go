@@ -0,0 +1,61 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// WrapComponent creates a higher-order component. When the returned component renders,
+// wrapper is called with its props and a render function that renders inner with the
+// provided props. wrapper can modify the props (eg. inject additional props), intercept
+// the render (eg. for logging) or wrap the element (eg. with a context provider).
+// Hooks can be used inside wrapper.
+//
+// The displayName of the returned component is "Wrap(<name of inner>)".
+//
+// Example:
+//
+//  ThemeContext, _, _ := react.CreateContext("light")
+//
+//  // withTheme injects the "theme" prop from ThemeContext
+//  withTheme := func(component *js.Object) *js.Object {
+//      return react.WrapComponent(component, func(props map[string]interface{}, render func(map[string]interface{}) *js.Object) *js.Object {
+//          props["theme"] = react.React.Call("useContext", ThemeContext).String()
+//          return render(props)
+//      })
+//  }
+//
+//  ThemedButton := withTheme(Button)
+//
+func WrapComponent(inner *js.Object, wrapper func(props map[string]interface{}, render func(map[string]interface{}) *js.Object) *js.Object) *js.Object {
+
+	render := func(props map[string]interface{}) *js.Object {
+		return React.Call("createElement", inner, SToMap(props))
+	}
+
+	wrapped := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		props, err := objectMap(arguments[0], "props")
+		if err != nil {
+			panic(err)
+		}
+		return wrapper(props, render)
+	})
+
+	SetDisplayName(wrapped, "Wrap("+componentName(inner)+")")
+	return wrapped
+}
+
+// componentName returns the displayName (or name) of a component.
+func componentName(component *js.Object) string {
+	if component == nil || component == js.Undefined {
+		return "Component"
+	}
+	if name := component.Get("displayName"); name != js.Undefined && name != nil && name.String() != "" {
+		return name.String()
+	}
+	if name := component.Get("name"); name != js.Undefined && name != nil && name.String() != "" {
+		return name.String()
+	}
+	return "Component"
+}
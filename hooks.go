@@ -0,0 +1,60 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// useState wraps React's useState hook. It must only be called
+// from the body of a functional component.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#usestate
+func useState(initialState interface{}) (*js.Object, func(interface{})) {
+	res := React.Call("useState", initialState)
+	setter := res.Index(1)
+	return res.Index(0), func(newState interface{}) {
+		setter.Invoke(newState)
+	}
+}
+
+// useEffect wraps React's useEffect hook. effect can return nil if
+// there is nothing to clean up. If deps is nil, the effect will run
+// after every render.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#useeffect
+func useEffect(effect func() func(), deps []interface{}) {
+	React.Call("useEffect", func() interface{} {
+		if cleanup := effect(); cleanup != nil {
+			return cleanup
+		}
+		// React complains if anything other than a function or undefined is returned.
+		return js.Undefined
+	}, deps)
+}
+
+// useLayoutEffect wraps React's useLayoutEffect hook. It is the same as
+// useEffect except that effect runs synchronously after the DOM is mutated.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#uselayouteffect
+func useLayoutEffect(effect func() func(), deps []interface{}) {
+	React.Call("useLayoutEffect", func() interface{} {
+		if cleanup := effect(); cleanup != nil {
+			return cleanup
+		}
+		return js.Undefined
+	}, deps)
+}
+
+// useGoRef wraps React's useRef hook so that a Go value can persist for the
+// full lifetime of the component. init is only called on the first render
+// and must return a pointer.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#useref
+func useGoRef(init func() interface{}) interface{} {
+	ref := React.Call("useRef", nil)
+	if ref.Get("current") == nil {
+		ref.Set("current", js.MakeWrapper(init()))
+	}
+	return ref.Get("current").Interface()
+}
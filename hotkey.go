@@ -0,0 +1,185 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// hotkey is a parsed key combination (eg. "ctrl+shift+s").
+type hotkey struct {
+	ctrl, alt, shift, meta bool
+	key                    string // lowercase value of KeyboardEvent.key
+}
+
+// hotkeyAliases maps the names that can be used in a combination to KeyboardEvent.key values.
+var hotkeyAliases = map[string]string{
+	"esc":    "escape",
+	"space":  " ",
+	"up":     "arrowup",
+	"down":   "arrowdown",
+	"left":   "arrowleft",
+	"right":  "arrowright",
+	"del":    "delete",
+	"return": "enter",
+	"plus":   "+",
+}
+
+// parseHotkey parses combo. The modifiers are ctrl, alt (or option), shift, meta (or cmd)
+// and mod (meta on macOS and ctrl elsewhere).
+func parseHotkey(combo string) hotkey {
+	var h hotkey
+
+	for _, part := range strings.Split(strings.ToLower(combo), "+") {
+		switch part = strings.TrimSpace(part); part {
+		case "ctrl", "control":
+			h.ctrl = true
+		case "alt", "option":
+			h.alt = true
+		case "shift":
+			h.shift = true
+		case "meta", "cmd", "command":
+			h.meta = true
+		case "mod":
+			if isMac() {
+				h.meta = true
+			} else {
+				h.ctrl = true
+			}
+		default:
+			if alias, exists := hotkeyAliases[part]; exists {
+				part = alias
+			}
+			h.key = part
+		}
+	}
+
+	return h
+}
+
+// isMac returns true if the browser is running on macOS (or iOS).
+func isMac() bool {
+	nav := js.Global.Get("navigator")
+	if nav == js.Undefined {
+		return false
+	}
+	platform := strings.ToLower(nav.Get("platform").String())
+	return strings.Contains(platform, "mac") || strings.Contains(platform, "iphone") || strings.Contains(platform, "ipad")
+}
+
+// matches returns true if the keydown event e matches the combination.
+func (h hotkey) matches(e *js.Object) bool {
+	if e.Get("ctrlKey").Bool() != h.ctrl || e.Get("altKey").Bool() != h.alt ||
+		e.Get("shiftKey").Bool() != h.shift || e.Get("metaKey").Bool() != h.meta {
+		return false
+	}
+
+	key := strings.ToLower(e.Get("key").String())
+	if key == h.key {
+		return true
+	}
+
+	// With alt (option on macOS), key is the character that was typed (eg. "ß" for alt+s),
+	// so the physical key is also checked for letters and digits.
+	code := e.Get("code").String()
+	return len(h.key) == 1 && (code == "Key"+strings.ToUpper(h.key) || code == "Digit"+h.key)
+}
+
+// hotkeyBinding is a field with the "hotkey" tag option.
+type hotkeyBinding struct {
+	hotkey         hotkey
+	handler        reflect.Value
+	preventDefault bool
+}
+
+// hotkeyBindingFor returns the binding of a field with the "hotkey" tag option.
+// The field must be a func(), func(*js.Object) or func(*SyntheticEvent).
+func hotkeyBindingFor(combo string, v reflect.Value, preventDefault bool) *hotkeyBinding {
+	switch v.Interface().(type) {
+	case func(), func(*js.Object), func(*SyntheticEvent):
+	default:
+		panic(&ConversionError{Kind: v.Kind(), Msg: "hotkey field must be a func(), func(*js.Object) or func(*SyntheticEvent)"})
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	return &hotkeyBinding{parseHotkey(combo), v, preventDefault}
+}
+
+// applyHotkeys wires a ref that listens for the key combinations of bindings on the document
+// while the element is mounted. It is used for the "hotkey" tag option, which is placed on a handler field.
+//
+// Example:
+//
+//  type EditorProps struct {
+//      Save  func()           `react:",hotkey=mod+s,preventdefault"`
+//      Close func(*js.Object) `react:",hotkey=esc"`
+//  }
+//
+// The combination consists of modifiers (ctrl, alt, shift, meta and mod, which is meta on macOS
+// and ctrl elsewhere) and a key (eg. "s", "enter", "esc", "space", "up" or "f2") separated by "+".
+// With the "preventdefault" option, the browser's default action (eg. saving the page) is prevented.
+// The field itself is not added to the props, and an existing ref (object or callback) is still set.
+func applyHotkeys(out map[string]interface{}, bindings []hotkeyBinding) {
+
+	if IsNative() {
+		// There is no keyboard
+		return
+	}
+
+	// toJSFunc passes a ref object through
+	prev := toJSFunc(out["ref"])
+
+	onKeyDown := func(e *js.Object) {
+		for _, b := range bindings {
+			if !b.hotkey.matches(e) {
+				continue
+			}
+
+			if b.preventDefault {
+				e.Call("preventDefault")
+			}
+
+			switch fn := b.handler.Interface().(type) {
+			case func():
+				fn()
+			case func(*js.Object):
+				fn(e)
+			case func(*SyntheticEvent):
+				fn(&SyntheticEvent{O: e})
+			}
+		}
+	}
+
+	// A new ref function is created for every conversion, so React calls the previous
+	// one with null (removing the listener) and this one with the node after every render.
+	var attached bool
+	out["ref"] = func(node *js.Object) {
+		// Keep the existing ref working
+		if prev != nil {
+			if prev.Get("call") != js.Undefined {
+				prev.Invoke(node)
+			} else {
+				prev.Set("current", node)
+			}
+		}
+
+		document := js.Global.Get("document")
+		if node == nil {
+			if attached {
+				attached = false
+				document.Call("removeEventListener", "keydown", onKeyDown)
+			}
+			return
+		}
+
+		if !attached {
+			attached = true
+			document.Call("addEventListener", "keydown", onKeyDown)
+		}
+	}
+}
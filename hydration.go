@@ -0,0 +1,129 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ServerNow is the time at which the server rendered the page. It should be
+// set (from a value embedded in the page by the server) before hydrating so that
+// UseNow renders the same time as the server. If it is not set, UseNow uses the
+// time when the component first renders.
+var ServerNow time.Time
+
+// UseNow is a hook that returns the current time. The first render returns ServerNow so
+// that the markup matches the server's markup. After the component mounts, the time
+// is updated immediately and then every refresh. If refresh is 0, it is only updated once.
+//
+// For components that render the current time (eg. "3 minutes ago"), it removes these warnings:
+//
+//  React 16/17  Warning: Text content did not match. Server: "%s" Client: "%s"
+//  React 18     Warning: Text content did not match. Server: "%s" Client: "%s"
+//               Error: Text content does not match server-rendered HTML.
+//
+// It does not remove warnings caused by ServerNow not being set (or being set to a different time).
+//
+// Example:
+//
+//  now := react.UseNow(time.Minute)
+//  return elements.Span(nil, "Updated "+strconv.Itoa(int(now.Sub(updated).Minutes()))+" minutes ago")
+//
+func UseNow(refresh time.Duration) time.Time {
+
+	initial := ServerNow
+	if initial.IsZero() {
+		initial = time.Now()
+	}
+
+	now, setNow := useState(initial.UnixNano() / int64(time.Millisecond))
+
+	useEffect(func() func() {
+		tick := func() {
+			setNow(time.Now().UnixNano() / int64(time.Millisecond))
+		}
+		tick()
+
+		if refresh <= 0 {
+			return nil
+		}
+
+		timer := js.Global.Call("setInterval", tick, int64(refresh/time.Millisecond))
+		return func() {
+			js.Global.Call("clearInterval", timer)
+		}
+	}, []interface{}{int64(refresh)})
+
+	ms := now.Int64()
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// StableRandom is a hook that returns a pseudo-random number in the range [0, 1).
+// The number is derived from the component's identity (using React's useId) and seedKey,
+// so the server and the client produce the same number. Different seedKeys can be used for
+// multiple numbers within the same component.
+//
+// For components that render random ids or random content, it removes these warnings:
+//
+//  React 16/17  Warning: Prop `%s` did not match. Server: %s Client: %s
+//               Warning: Text content did not match. Server: "%s" Client: "%s"
+//  React 18     the same warnings and Error: Text content does not match server-rendered HTML.
+//
+// NOTE: React 18+ is required to identify the component. For older versions,
+// the number only depends on seedKey.
+func StableRandom(seedKey string) float64 {
+
+	id := ""
+	if useID := React.Get("useId"); useID != js.Undefined {
+		id = React.Call("useId").String()
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(id))
+	h.Write([]byte{0})
+	h.Write([]byte(seedKey))
+
+	// Use the top 53 bits to fill a float64's mantissa
+	return float64(h.Sum64()>>11) / (1 << 53)
+}
+
+// HydrationSafe renders render(false) on the server and on the first client render,
+// and render(true) after the component has mounted. It is used for content that can only
+// be determined on the client (eg. the user's timezone, the window's size or localStorage).
+//
+// It removes these warnings (since render(true) is never used while hydrating):
+//
+//  React 16/17  Warning: Expected server HTML to contain a matching <%s> in <%s>.
+//               Warning: Did not expect server HTML to contain a <%s> in <%s>.
+//               Warning: Text content did not match. Server: "%s" Client: "%s"
+//               Warning: Prop `%s` did not match. Server: %s Client: %s
+//  React 18     the same warnings and Error: Hydration failed because the initial UI
+//               does not match what was rendered on the server.
+//
+// Example:
+//
+//  react.HydrationSafe(func(isClient bool) interface{} {
+//      if !isClient {
+//          return nil
+//      }
+//      return elements.Span(nil, js.Global.Get("localStorage").Call("getItem", "name").String())
+//  })
+//
+func HydrationSafe(render func(isClient bool) interface{}) interface{} {
+	return JSX(hydrationSafeComponent, map[string]interface{}{"render": render})
+}
+
+// hydrationSafeComponent is the functional component used by HydrationSafe.
+var hydrationSafeComponent = NamedComponent("HydrationSafe", func(this *js.Object, arguments []*js.Object) interface{} {
+	isClient, setIsClient := useState(false)
+
+	useEffect(func() func() {
+		setIsClient(true)
+		return nil
+	}, []interface{}{})
+
+	return arguments[0].Get("render").Invoke(isClient.Bool())
+})
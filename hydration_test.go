@@ -0,0 +1,102 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test in Node.js with react, react-dom (16 or 17)
+// and jsdom installed.
+
+// hydrateServerMarkup renders element to a string (like the server), puts the markup in
+// container and hydrates it using Hydrate. It returns the errors and warnings logged by React.
+func hydrateServerMarkup(t *testing.T, container *js.Object, element func() *js.Object) []string {
+
+	server := js.Global.Call("require", "react-dom/server")
+	container.Set("innerHTML", server.Call("renderToString", element()).String())
+
+	console := js.Global.Get("console")
+	origError := console.Get("error")
+	logged := []string{}
+	console.Set("error", func(args ...interface{}) {
+		logged = append(logged, js.Global.Get("String").Invoke(args[0]).String())
+	})
+	defer console.Set("error", origError)
+
+	// act runs the effects before returning
+	testUtils := js.Global.Call("require", "react-dom/test-utils")
+	testUtils.Call("act", func() {
+		Hydrate(element(), container)
+	})
+	return logged
+}
+
+func assertNoMismatch(t *testing.T, logged []string) {
+	t.Helper()
+	for _, msg := range logged {
+		if strings.Contains(msg, "did not match") || strings.Contains(msg, "server HTML") {
+			t.Errorf("unexpected hydration warning: %s", msg)
+		}
+	}
+}
+
+func TestHydrateUseNow(t *testing.T) {
+	container := setupDOM(t)
+
+	ServerNow = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer func() { ServerNow = time.Time{} }()
+
+	component := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		return JSX("span", nil, strconv.FormatInt(UseNow(0).Unix(), 10))
+	})
+
+	logged := hydrateServerMarkup(t, container, func() *js.Object { return JSX(component, nil) })
+	assertNoMismatch(t, logged)
+
+	// The time is updated after mounting
+	if text := container.Get("textContent").String(); text == strconv.FormatInt(ServerNow.Unix(), 10) {
+		t.Errorf("expected the time to be updated after mounting, got %s", text)
+	}
+}
+
+func TestHydrateStableRandom(t *testing.T) {
+	container := setupDOM(t)
+
+	component := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		id := "id-" + strconv.FormatFloat(StableRandom("id"), 'f', -1, 64)
+		return JSX("span", map[string]interface{}{"id": id}, id)
+	})
+
+	logged := hydrateServerMarkup(t, container, func() *js.Object { return JSX(component, nil) })
+	assertNoMismatch(t, logged)
+}
+
+func TestHydrateHydrationSafe(t *testing.T) {
+	container := setupDOM(t)
+
+	element := func() *js.Object {
+		return JSX("div", nil, HydrationSafe(func(isClient bool) interface{} {
+			if !isClient {
+				return JSX("span", nil, "server")
+			}
+			return JSX("b", map[string]interface{}{"title": "client"}, "client")
+		}))
+	}
+
+	logged := hydrateServerMarkup(t, container, element)
+	assertNoMismatch(t, logged)
+
+	// The client branch is rendered after mounting
+	if text := container.Get("textContent").String(); text != "client" {
+		t.Errorf("expected the client branch after mounting, got %s", text)
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	// ErrIDBUnavailable is returned by OpenDB when the browser does not support IndexedDB.
+	ErrIDBUnavailable = errors.New("react: indexedDB is not available")
+
+	// ErrIDBNotFound is returned by IDBStore.Get when the key does not exist.
+	ErrIDBNotFound = errors.New("react: key not found")
+
+	// ErrIDBQuotaExceeded is returned when the browser's storage quota has been exceeded.
+	ErrIDBQuotaExceeded = errors.New("react: indexedDB quota exceeded")
+)
+
+// IDBVersionError is returned by OpenDB when the requested version is lower
+// than the existing version of the database (usually because another tab is
+// running a newer version of the app).
+type IDBVersionError struct {
+	Name    string
+	Version int
+}
+
+// Error implements the error interface.
+func (e *IDBVersionError) Error() string {
+	return "react: indexedDB " + e.Name + " has a version higher than " + strconv.Itoa(e.Version)
+}
+
+// idbError converts a DOMException into an error.
+func idbError(domErr *js.Object) error {
+	if domErr == nil || domErr == js.Undefined {
+		return errors.New("react: indexedDB request failed")
+	}
+
+	switch domErr.Get("name").String() {
+	case "QuotaExceededError":
+		return ErrIDBQuotaExceeded
+	default:
+		return &js.Error{Object: domErr}
+	}
+}
+
+// IDB is an open IndexedDB database.
+//
+// NOTE: Apart from OpenDB's migrate function, all methods of IDB and IDBStore block,
+// so they must be called from a goroutine.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/IndexedDB_API
+type IDB struct {
+	O *js.Object
+}
+
+// IDBIndex describes an index of an object store.
+type IDBIndex struct {
+	Name    string
+	KeyPath string
+	Unique  bool
+}
+
+// OpenDB opens the database with the given name and version. migrate is called
+// when the database is created or when version is higher than the existing version.
+// It is used to create object stores and indexes.
+//
+// Example:
+//
+//  go func() {
+//      db, err := react.OpenDB("app", 2, func(db *react.IDB, oldVersion int) {
+//          if oldVersion < 1 {
+//              db.CreateStore("users", "id", react.IDBIndex{Name: "by_email", KeyPath: "email", Unique: true})
+//          }
+//          if oldVersion < 2 {
+//              db.CreateStore("posts", "id")
+//          }
+//      })
+//  }()
+//
+func OpenDB(name string, version int, migrate func(db *IDB, oldVersion int)) (_ *IDB, rErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = idbError(err.Object)
+		}
+	}()
+
+	factory := js.Global.Get("indexedDB")
+	if factory == nil || factory == js.Undefined {
+		return nil, ErrIDBUnavailable
+	}
+
+	req := factory.Call("open", name, version)
+
+	if migrate != nil {
+		req.Set("onupgradeneeded", func(e *js.Object) {
+			migrate(&IDB{O: req.Get("result")}, e.Get("oldVersion").Int())
+		})
+	}
+
+	ch := make(chan error, 1)
+	req.Set("onsuccess", func(e *js.Object) {
+		ch <- nil
+	})
+	req.Set("onerror", func(e *js.Object) {
+		e.Call("preventDefault")
+		domErr := req.Get("error")
+		if domErr != nil && domErr.Get("name").String() == "VersionError" {
+			ch <- &IDBVersionError{Name: name, Version: version}
+			return
+		}
+		ch <- idbError(domErr)
+	})
+
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+
+	db := &IDB{O: req.Get("result")}
+
+	// Allow other tabs to upgrade the database
+	db.O.Set("onversionchange", func(e *js.Object) {
+		db.O.Call("close")
+	})
+
+	return db, nil
+}
+
+// CreateStore creates an object store. It must only be called from OpenDB's migrate function.
+// If keyPath is empty, the keys are generated automatically.
+func (db *IDB) CreateStore(name string, keyPath string, indexes ...IDBIndex) (rErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = idbError(err.Object)
+		}
+	}()
+
+	params := js.M{}
+	if keyPath == "" {
+		params["autoIncrement"] = true
+	} else {
+		params["keyPath"] = keyPath
+	}
+
+	store := db.O.Call("createObjectStore", name, params)
+	for _, idx := range indexes {
+		store.Call("createIndex", idx.Name, idx.KeyPath, js.M{"unique": idx.Unique})
+	}
+
+	return nil
+}
+
+// DeleteStore deletes an object store. It must only be called from OpenDB's migrate function.
+func (db *IDB) DeleteStore(name string) {
+	db.O.Call("deleteObjectStore", name)
+}
+
+// Close closes the database.
+func (db *IDB) Close() {
+	db.O.Call("close")
+}
+
+// Store returns the object store with the given name.
+func (db *IDB) Store(name string) *IDBStore {
+	return &IDBStore{db: db, name: name}
+}
+
+// IDBQuery selects records from an object store or one of its indexes.
+// The zero value selects all records.
+type IDBQuery struct {
+	// Index is the name of the index to query. If empty, the object store's keys are used.
+	Index string
+
+	// Only selects records with the given key.
+	Only interface{}
+
+	// Lower and Upper select a range of keys. Either can be nil.
+	Lower     interface{}
+	Upper     interface{}
+	LowerOpen bool // exclude Lower from the range
+	UpperOpen bool // exclude Upper from the range
+
+	// Limit is the maximum number of records returned. 0 means no limit.
+	Limit int
+}
+
+// keyRange returns the IDBKeyRange for the query or nil if all keys are selected.
+func (q IDBQuery) keyRange() *js.Object {
+	kr := js.Global.Get("IDBKeyRange")
+	switch {
+	case q.Only != nil:
+		return kr.Call("only", q.Only)
+	case q.Lower != nil && q.Upper != nil:
+		return kr.Call("bound", q.Lower, q.Upper, q.LowerOpen, q.UpperOpen)
+	case q.Lower != nil:
+		return kr.Call("lowerBound", q.Lower, q.LowerOpen)
+	case q.Upper != nil:
+		return kr.Call("upperBound", q.Upper, q.UpperOpen)
+	}
+	return nil
+}
+
+// IDBStore is an object store of a database. Structs are stored using SToMap and
+// retrieved using UnmarshalStruct, so the "react" struct tag is used to name fields.
+type IDBStore struct {
+	db   *IDB
+	name string
+}
+
+// Name returns the name of the object store.
+func (s *IDBStore) Name() string {
+	return s.name
+}
+
+// request runs fn inside a transaction and waits for the transaction to complete.
+// It returns the result of the request returned by fn.
+func (s *IDBStore) request(mode string, fn func(store *js.Object) *js.Object) (_ *js.Object, rErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = idbError(err.Object)
+		}
+	}()
+
+	tx := s.db.O.Call("transaction", s.name, mode)
+	req := fn(tx.Call("objectStore", s.name))
+
+	ch := make(chan error, 1)
+	tx.Set("oncomplete", func(e *js.Object) {
+		ch <- nil
+	})
+	tx.Set("onabort", func(e *js.Object) {
+		if err := tx.Get("error"); err != nil {
+			ch <- idbError(err)
+			return
+		}
+		ch <- idbError(req.Get("error"))
+	})
+
+	if err := <-ch; err != nil {
+		return nil, err
+	}
+	return req.Get("result"), nil
+}
+
+// Put adds or replaces a record. v can be a struct or a map. key is only
+// required if the object store does not have a key path. The record's key is returned.
+func (s *IDBStore) Put(v interface{}, key ...interface{}) (*js.Object, error) {
+	args := []interface{}{SToMap(v)}
+	if len(key) > 0 {
+		args = append(args, key[0])
+	}
+
+	return s.request("readwrite", func(store *js.Object) *js.Object {
+		return store.Call("put", args...)
+	})
+}
+
+// Get retrieves the record with the given key and stores it in dest,
+// which must be a pointer to a struct. ErrIDBNotFound is returned if the key does not exist.
+func (s *IDBStore) Get(key interface{}, dest interface{}) error {
+	res, err := s.request("readonly", func(store *js.Object) *js.Object {
+		return store.Call("get", key)
+	})
+	if err != nil {
+		return err
+	}
+
+	if res == nil || res == js.Undefined {
+		return ErrIDBNotFound
+	}
+	return UnmarshalStruct(res.Interface().(map[string]interface{}), dest)
+}
+
+// Delete removes the record with the given key.
+func (s *IDBStore) Delete(key interface{}) error {
+	_, err := s.request("readwrite", func(store *js.Object) *js.Object {
+		return store.Call("delete", key)
+	})
+	return err
+}
+
+// GetAll retrieves the records selected by q and stores them in dest,
+// which must be a pointer to a slice of structs.
+//
+// Example:
+//
+//  var users []User
+//  err := db.Store("users").GetAll(react.IDBQuery{Index: "by_age", Lower: 18}, &users)
+//
+func (s *IDBStore) GetAll(q IDBQuery, dest interface{}) error {
+	res, err := s.request("readonly", func(store *js.Object) *js.Object {
+		source := store
+		if q.Index != "" {
+			source = store.Call("index", q.Index)
+		}
+
+		if q.Limit > 0 {
+			return source.Call("getAll", q.keyRange(), q.Limit)
+		}
+		return source.Call("getAll", q.keyRange())
+	})
+	if err != nil {
+		return err
+	}
+
+	slc := reflect.ValueOf(dest).Elem()
+	out := reflect.MakeSlice(slc.Type(), 0, res.Length())
+	for i := 0; i < res.Length(); i++ {
+		e := reflect.New(slc.Type().Elem())
+		if err := UnmarshalStruct(res.Index(i).Interface().(map[string]interface{}), e.Interface()); err != nil {
+			return err
+		}
+		out = reflect.Append(out, e.Elem())
+	}
+	slc.Set(out)
+
+	return nil
+}
+
+// UseIDBQuery is a hook that runs q against store and stores the records in dest,
+// which must be a pointer to a slice of structs. The query is run again whenever
+// the store or q changes. The component re-renders when the results are ready.
+//
+// NOTE: The values in q should be primitives (strings, numbers etc.) since they
+// are compared with the previous render's values.
+//
+// Example:
+//
+//  var users []User
+//  loading, err := react.UseIDBQuery(db.Store("users"), react.IDBQuery{Index: "by_age", Lower: minAge}, &users)
+//
+func UseIDBQuery(store *IDBStore, q IDBQuery, dest interface{}) (loading bool, err error) {
+
+	if IsNative() {
+		return false, ErrNotSupported
+	}
+
+	type queryState struct {
+		loading bool
+		err     error
+		result  reflect.Value
+		version int
+	}
+
+	st := useGoRef(func() interface{} {
+		return &queryState{loading: true}
+	}).(*queryState)
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		cancelled := false
+
+		if !st.loading || st.err != nil {
+			// The query has changed, so the previous results are stale
+			st.loading = true
+			st.err = nil
+			st.version++
+			setVersion(st.version)
+		}
+
+		go func() {
+			tmp := reflect.New(reflect.TypeOf(dest).Elem())
+			err := store.GetAll(q, tmp.Interface())
+			if cancelled {
+				return
+			}
+
+			st.loading = false
+			st.err = err
+			st.result = tmp.Elem()
+			st.version++
+			setVersion(st.version)
+		}()
+
+		return func() {
+			cancelled = true
+		}
+	}, []interface{}{store.db.O, store.name, q.Index, q.Only, q.Lower, q.Upper, q.LowerOpen, q.UpperOpen, q.Limit})
+
+	if st.result.IsValid() {
+		reflect.ValueOf(dest).Elem().Set(st.result)
+	}
+
+	return st.loading, st.err
+}
@@ -0,0 +1,162 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !native
+// +build !native
+
+package react
+
+import (
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ImpressionOptions configures TrackImpression.
+type ImpressionOptions struct {
+	// Threshold is the proportion of the element that must be visible (between 0 and 1).
+	// The default is 0.5.
+	Threshold float64
+
+	// MinDuration is how long the element must be continuously visible. The default is 1 second.
+	MinDuration time.Duration
+
+	// Once limits the impression to firing once while the component is mounted. Otherwise,
+	// it fires again each time the element becomes visible (for MinDuration) after being hidden.
+	Once bool
+
+	// Meta is converted using SToMap (so it is typically a struct) and provided to OnImpression.
+	Meta interface{}
+
+	// OnImpression is called with the converted Meta. If it is nil, the AnalyticsTracker
+	// (see RegisterAnalyticsTracker) is called with the event "impression".
+	OnImpression func(meta map[string]interface{})
+}
+
+// TrackImpression is a hook that records an impression when the element of ref is visible
+// (see ImpressionOptions.Threshold) for ImpressionOptions.MinDuration. The time only counts
+// while the page is visible: the timer is stopped when the user switches tabs (or minimizes
+// the window) and it starts again when they return. The observer, listener and timer are
+// removed when the component unmounts.
+//
+// If the browser doesn't support IntersectionObserver, the element is treated as visible.
+//
+// Example:
+//
+//  type PromoMeta struct {
+//      PromoID  string `react:"promoId"`
+//      Position int    `react:"position"`
+//  }
+//
+//  ref := react.React.Call("useRef", nil)
+//  react.TrackImpression(ref, react.ImpressionOptions{
+//      Once: true,
+//      Meta: PromoMeta{PromoID: promo.ID, Position: 2},
+//      OnImpression: func(meta map[string]interface{}) {
+//          js.Global.Get("analytics").Call("track", "promo_impression", meta)
+//      },
+//  })
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/Page_Visibility_API
+func TrackImpression(ref *js.Object, opts ImpressionOptions) {
+
+	type impressionState struct {
+		opts ImpressionOptions
+	}
+
+	st := useGoRef(func() interface{} {
+		return &impressionState{}
+	}).(*impressionState)
+	st.opts = opts
+
+	threshold := opts.Threshold
+	if threshold <= 0 {
+		threshold = 0.5
+	}
+
+	useEffect(func() func() {
+		node := ref.Get("current")
+		if node == nil || node == js.Undefined {
+			return nil
+		}
+
+		document := js.Global.Get("document")
+
+		var (
+			visible     bool // the element is visible
+			pageVisible = document.Get("visibilityState").String() != "hidden"
+			counted     bool // an impression was recorded since the element became visible
+			fired       bool
+			timer       *js.Object
+		)
+
+		fire := func() {
+			timer = nil
+			counted = true
+			fired = true
+
+			meta := map[string]interface{}{}
+			if mp := SToMap(st.opts.Meta); mp != nil {
+				meta = mp
+			}
+
+			if st.opts.OnImpression != nil {
+				st.opts.OnImpression(meta)
+			} else if analyticsTracker != nil {
+				analyticsTracker("impression", meta)
+			}
+		}
+
+		// update starts (or stops) the timer
+		update := func() {
+			if !visible {
+				counted = false
+			}
+
+			if visible && pageVisible && !counted && !(st.opts.Once && fired) {
+				if timer == nil {
+					d := st.opts.MinDuration
+					if d <= 0 {
+						d = time.Second
+					}
+					timer = js.Global.Call("setTimeout", fire, d.Milliseconds())
+				}
+			} else if timer != nil {
+				js.Global.Call("clearTimeout", timer)
+				timer = nil
+			}
+		}
+
+		onVisibilityChange := func() {
+			pageVisible = document.Get("visibilityState").String() != "hidden"
+			update()
+		}
+		document.Call("addEventListener", "visibilitychange", onVisibilityChange)
+
+		var observer *js.Object
+		if observerClass := js.Global.Get("IntersectionObserver"); observerClass != js.Undefined {
+			observer = observerClass.New(func(entries *js.Object) {
+				if entries.Length() == 0 {
+					return
+				}
+				// The last entry is the most recent
+				entry := entries.Index(entries.Length() - 1)
+				visible = entry.Get("isIntersecting").Bool() && entry.Get("intersectionRatio").Float() >= threshold
+				update()
+			}, map[string]interface{}{"threshold": threshold})
+			observer.Call("observe", node)
+		} else {
+			visible = true
+			update()
+		}
+
+		return func() {
+			if observer != nil {
+				observer.Call("disconnect")
+			}
+			document.Call("removeEventListener", "visibilitychange", onVisibilityChange)
+			if timer != nil {
+				js.Global.Call("clearTimeout", timer)
+			}
+		}
+	}, []interface{}{threshold})
+}
@@ -0,0 +1,24 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build native
+// +build native
+
+package react
+
+import (
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ImpressionOptions configures TrackImpression.
+type ImpressionOptions struct {
+	Threshold    float64
+	MinDuration  time.Duration
+	Once         bool
+	Meta         interface{}
+	OnImpression func(meta map[string]interface{})
+}
+
+// TrackImpression does nothing in React Native, since there is no IntersectionObserver.
+func TrackImpression(ref *js.Object, opts ImpressionOptions) {}
@@ -0,0 +1,164 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// JestBridge is used to write tests in Go that are run by Jest (in Node.js).
+// The compiled test file must be run by Jest, which provides the describe, it
+// and expect globals.
+//
+// Example:
+//
+//  func main() {
+//      j := react.NewJestBridge()
+//
+//      j.Describe("Counter", func() {
+//          j.It("renders the initial count", func() {
+//              out := renderToString(react.JSX(Counter, &CounterProps{Count: 3}))
+//              j.Expect(out).ToEqual("<div>3</div>")
+//          })
+//
+//          j.It("calls onChange", func() {
+//              onChange := j.Fn()
+//              ...
+//              j.Expect(onChange).ToHaveBeenCalledWith(4)
+//          })
+//      })
+//  }
+//
+// See: https://jestjs.io/docs/api
+type JestBridge struct {
+	global *js.Object
+}
+
+// NewJestBridge returns a JestBridge. It panics if the Jest globals are not defined.
+func NewJestBridge() *JestBridge {
+	if js.Global.Get("describe") == js.Undefined || js.Global.Get("expect") == js.Undefined {
+		panic("react: jest globals not found (the tests must be run by jest)")
+	}
+	return &JestBridge{global: js.Global}
+}
+
+// jestCallback converts fn into a function that returns a Promise, so that fn runs in a
+// goroutine and can block (eg. using Await). A failed assertion (or panic) rejects the
+// Promise, which fails the test.
+func jestCallback(fn func()) func() *js.Object {
+	return func() *js.Object {
+		return js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+			go func() {
+				defer func() {
+					if r := recover(); r != nil {
+						reject.Invoke(jsError(panicError(r)))
+					}
+				}()
+				fn()
+				resolve.Invoke()
+			}()
+		})
+	}
+}
+
+// Describe creates a block that groups related tests.
+//
+// See: https://jestjs.io/docs/api#describename-fn
+func (j *JestBridge) Describe(name string, fn func()) {
+	// describe must register its tests synchronously
+	j.global.Call("describe", name, fn)
+}
+
+// It runs a test. fn runs in a goroutine, so it can block.
+//
+// See: https://jestjs.io/docs/api#testname-fn-timeout
+func (j *JestBridge) It(name string, fn func()) {
+	j.global.Call("it", name, jestCallback(fn))
+}
+
+// BeforeEach runs fn before each test in the current Describe block.
+//
+// See: https://jestjs.io/docs/api#beforeeachfn-timeout
+func (j *JestBridge) BeforeEach(fn func()) {
+	j.global.Call("beforeEach", jestCallback(fn))
+}
+
+// AfterEach runs fn after each test in the current Describe block.
+//
+// See: https://jestjs.io/docs/api#aftereachfn-timeout
+func (j *JestBridge) AfterEach(fn func()) {
+	j.global.Call("afterEach", jestCallback(fn))
+}
+
+// Fn returns a mock function (jest.fn), which can be checked using
+// ToHaveBeenCalled and ToHaveBeenCalledWith. impl is optionally the implementation.
+//
+// See: https://jestjs.io/docs/mock-function-api
+func (j *JestBridge) Fn(impl ...interface{}) *js.Object {
+	if len(impl) > 0 {
+		return j.global.Get("jest").Call("fn", impl[0])
+	}
+	return j.global.Get("jest").Call("fn")
+}
+
+// Expect starts an assertion about value. Structs are converted using SToMap.
+//
+// See: https://jestjs.io/docs/expect
+func (j *JestBridge) Expect(value interface{}) *Assertion {
+	return &Assertion{O: j.global.Call("expect", jestValue(value))}
+}
+
+// jestValue converts structs using SToMap, so that they can be compared with ToEqual.
+func jestValue(v interface{}) interface{} {
+	if _, ok := v.(*js.Object); !ok && isStruct(v) {
+		return SToMap(v)
+	}
+	return v
+}
+
+// Assertion is returned by JestBridge.Expect. A failed assertion panics
+// (with the error thrown by Jest), which fails the test.
+type Assertion struct {
+	// O is the object returned by expect.
+	O *js.Object
+}
+
+// Not negates the assertion.
+func (a *Assertion) Not() *Assertion {
+	return &Assertion{O: a.O.Get("not")}
+}
+
+// ToEqual checks that the value is recursively equal to expected.
+func (a *Assertion) ToEqual(expected interface{}) {
+	a.O.Call("toEqual", jestValue(expected))
+}
+
+// ToBe checks that the value is the same as expected (using Object.is).
+func (a *Assertion) ToBe(expected interface{}) {
+	a.O.Call("toBe", expected)
+}
+
+// ToBeNull checks that the value is null.
+func (a *Assertion) ToBeNull() {
+	a.O.Call("toBeNull")
+}
+
+// ToBeDefined checks that the value is not undefined.
+func (a *Assertion) ToBeDefined() {
+	a.O.Call("toBeDefined")
+}
+
+// ToHaveBeenCalled checks that the mock function (see JestBridge.Fn) was called.
+func (a *Assertion) ToHaveBeenCalled() {
+	a.O.Call("toHaveBeenCalled")
+}
+
+// ToHaveBeenCalledWith checks that the mock function (see JestBridge.Fn) was
+// called with args.
+func (a *Assertion) ToHaveBeenCalledWith(args ...interface{}) {
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		converted[i] = jestValue(arg)
+	}
+	a.O.Call("toHaveBeenCalledWith", converted...)
+}
@@ -0,0 +1,138 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// stringer is the same as fmt.Stringer. fmt is not imported
+// since it significantly increases the size of the generated javascript.
+type stringer interface {
+	String() string
+}
+
+// keyString converts the value of a key field to a string. Strings, integers
+// and values that implement fmt.Stringer are supported. ok is false for other
+// values (and nil pointers).
+func keyString(v reflect.Value) (_ string, ok bool) {
+
+	if s, ok := v.Interface().(stringer); ok {
+		if _, isNil := indirect(v); isNil {
+			return "", false
+		}
+		return s.String(), true
+	}
+
+	v, isNil := indirect(v)
+	if isNil {
+		return "", false
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	}
+	return "", false
+}
+
+// WithKeys converts a slice of structs using SToMap and adds the value of keyField
+// (the Go name or the key of a field) to each map as the "key" prop, so that the
+// maps can be used to render a list of elements. Integers and values that implement
+// fmt.Stringer are converted to strings.
+//
+// Alternatively, SToMap adds the "key" prop for a field with the "key" tag
+// option (eg. `react:"id,key"`), unless the field is a zero value.
+//
+// It panics if keyField is missing, or if a key is a zero value or a duplicate,
+// since those lead to subtle bugs when React reconciles the list.
+//
+// Example:
+//
+//  for _, props := range react.WithKeys(todos, "ID") {
+//      items = append(items, react.JSX(TodoItem, props))
+//  }
+//
+func WithKeys(slice interface{}, keyField string) []map[string]interface{} {
+	out, err := WithKeysE(slice, keyField)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// WithKeysE is the same as WithKeys except that a *ConversionError is
+// returned instead of panicking.
+func WithKeysE(slice interface{}, keyField string) (_ []map[string]interface{}, rErr error) {
+
+	v, isNil := indirect(reflect.ValueOf(slice))
+	if !v.IsValid() || (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) {
+		return nil, &ConversionError{Kind: v.Kind(), Msg: "WithKeys: argument must be a slice of structs"}
+	}
+	if isNil || (v.Kind() == reflect.Slice && v.IsNil()) {
+		return nil, nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			cErr, ok := r.(*ConversionError)
+			if !ok {
+				panic(r)
+			}
+			rErr = cErr
+		}
+	}()
+
+	out := make([]map[string]interface{}, 0, v.Len())
+	seen := map[string]int{}
+
+	for i := 0; i < v.Len(); i++ {
+		path := "[" + strconv.Itoa(i) + "]"
+
+		e := v.Index(i)
+		if e.Kind() == reflect.Interface {
+			e = e.Elem()
+		}
+
+		s, isNil := indirect(e)
+		if !s.IsValid() || isNil || s.Kind() != reflect.Struct {
+			return nil, &ConversionError{Kind: s.Kind(), Path: path, Msg: "WithKeys: element must be a struct"}
+		}
+
+		var field reflect.Value
+		for _, fi := range cachedFields(s.Type(), defaultOptions) {
+			if !fi.embedded && (fi.name == keyField || fi.key == keyField) {
+				field = s.Field(fi.index)
+				break
+			}
+		}
+		if !field.IsValid() {
+			return nil, &ConversionError{Kind: s.Kind(), Path: path, Msg: "WithKeys: key field " + keyField + " not found"}
+		}
+
+		if field.IsZero() {
+			return nil, &ConversionError{Kind: field.Kind(), Path: path + "." + keyField, Msg: "WithKeys: key is a zero value"}
+		}
+
+		key, ok := keyString(field)
+		if !ok {
+			return nil, &ConversionError{Kind: field.Kind(), Path: path + "." + keyField, Msg: "WithKeys: unsupported key type " + field.Type().String()}
+		}
+
+		if j, exists := seen[key]; exists {
+			return nil, &ConversionError{Kind: field.Kind(), Path: path + "." + keyField, Msg: "WithKeys: duplicate key " + strconv.Quote(key) + " (also used by element " + strconv.Itoa(j) + ")"}
+		}
+		seen[key] = i
+
+		mp := convertStruct(s.Interface(), defaultOptions)
+		mp["key"] = key
+		out = append(out, mp)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	// skeletons maps components (as javascript functions) to their skeletons.
+	skeletons *js.Object
+
+	defaultSkeleton interface{}
+)
+
+// RegisterSkeleton registers the component that ElementFromStruct renders instead of
+// component while its props are loading. The skeleton receives the same props.
+//
+// Example:
+//
+//  react.RegisterSkeleton(UserCard, UserCardSkeleton)
+//
+func RegisterSkeleton(component interface{}, skeleton interface{}) {
+	if skeletons == nil {
+		skeletons = js.Global.Get("WeakMap").New()
+	}
+	skeletons.Call("set", toJSFunc(component), wrapBox(skeleton))
+}
+
+// RegisterDefaultSkeleton registers the skeleton used by ElementFromStruct for components
+// that don't have their own skeleton.
+func RegisterDefaultSkeleton(skeleton interface{}) {
+	defaultSkeleton = skeleton
+}
+
+// skeletonFor returns the skeleton of component (or the default skeleton).
+func skeletonFor(component interface{}) interface{} {
+	if _, ok := component.(string); !ok && skeletons != nil {
+		if sk := skeletons.Call("get", toJSFunc(component)); sk != js.Undefined {
+			return unwrapBox(sk)
+		}
+	}
+	return defaultSkeleton
+}
+
+// isLoading returns true if props is a struct with a true field that has the "loading" tag option.
+func isLoading(props interface{}) bool {
+
+	if props == nil || !isStruct(props) || jsObjectIsNotNil(props) {
+		return false
+	}
+
+	v, isNil := indirect(reflect.ValueOf(props))
+	if isNil {
+		return false
+	}
+
+	for _, fi := range cachedFields(v.Type(), defaultOptions) {
+		if !fi.loading {
+			continue
+		}
+
+		field, isNil := indirect(v.Field(fi.index))
+		if field.Kind() != reflect.Bool {
+			panic(&ConversionError{Kind: field.Kind(), Path: fi.name, Msg: "loading field must be a bool"})
+		}
+		if !isNil && field.Bool() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ElementFromStruct is the same as JSX except that if props has a field with the "loading"
+// tag option that is true, the skeleton registered for component (using RegisterSkeleton
+// or RegisterDefaultSkeleton) is rendered instead. The skeleton receives the same props
+// but not the children. The field itself is not added to the props.
+// If no skeleton is registered, component is rendered.
+//
+// Example:
+//
+//  type UserCardProps struct {
+//      Name    string `react:"name"`
+//      Loading bool   `react:",loading"`
+//  }
+//
+//  react.ElementFromStruct(UserCard, &UserCardProps{Name: user.Name, Loading: user == nil})
+//
+func ElementFromStruct(component interface{}, props interface{}, children ...interface{}) *js.Object {
+	if isLoading(props) {
+		if skeleton := skeletonFor(component); skeleton != nil {
+			return JSX(skeleton, props)
+		}
+	}
+	return JSX(component, props, children...)
+}
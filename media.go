@@ -0,0 +1,256 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Breakpoint is a named media query used by the "media" tag option.
+type Breakpoint struct {
+	Name  string
+	Query string
+}
+
+// BaseBreakpoint is the key of the value used when no breakpoint matches.
+const BaseBreakpoint = "base"
+
+// DefaultBreakpoints are the breakpoints used by the "media" tag option
+// unless RegisterBreakpoints is called.
+var DefaultBreakpoints = []Breakpoint{
+	{"sm", "(min-width: 640px)"},
+	{"md", "(min-width: 768px)"},
+	{"lg", "(min-width: 1024px)"},
+	{"xl", "(min-width: 1280px)"},
+}
+
+var (
+	breakpoints = DefaultBreakpoints
+
+	// mediaQueryLists caches the results of window.matchMedia.
+	mediaQueryLists = map[string]*js.Object{}
+)
+
+// RegisterBreakpoints replaces the breakpoints used by the "media" tag option.
+// They must be ordered from the least to the most specific (eg. by increasing min-width),
+// since the last matching breakpoint wins.
+//
+// Example:
+//
+//  react.RegisterBreakpoints(
+//      react.Breakpoint{"tablet", "(min-width: 600px)"},
+//      react.Breakpoint{"desktop", "(min-width: 1200px)"},
+//  )
+//
+func RegisterBreakpoints(bps ...Breakpoint) {
+	breakpoints = bps
+}
+
+// mediaQueryList returns the MediaQueryList of query. It returns nil if
+// matchMedia is not available (eg. when rendering on the server).
+func mediaQueryList(query string) *js.Object {
+	if mql, exists := mediaQueryLists[query]; exists {
+		return mql
+	}
+
+	if js.Global.Get("matchMedia") == js.Undefined {
+		return nil
+	}
+
+	mql := js.Global.Call("matchMedia", query)
+	mediaQueryLists[query] = mql
+	return mql
+}
+
+// currentBreakpoint returns the name of the last matching breakpoint or BaseBreakpoint.
+func currentBreakpoint() string {
+	current := BaseBreakpoint
+	for _, bp := range breakpoints {
+		if mql := mediaQueryList(bp.Query); mql != nil && mql.Get("matches").Bool() {
+			current = bp.Name
+		}
+	}
+	return current
+}
+
+// mediaValue returns the value of the map v for the current viewport. The values of
+// matching breakpoints override the value of BaseBreakpoint. ok is false if no value matches.
+func mediaValue(v reflect.Value) (_ interface{}, ok bool) {
+
+	v, isNil := indirect(v)
+	if isNil {
+		return nil, false
+	}
+
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		panic(&ConversionError{Kind: v.Kind(), Msg: "media field must be a map with string keys"})
+	}
+
+	lookup := func(name string) (interface{}, bool) {
+		val := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !val.IsValid() {
+			return nil, false
+		}
+		return val.Interface(), true
+	}
+
+	out, ok := lookup(BaseBreakpoint)
+	for _, bp := range breakpoints {
+		if mql := mediaQueryList(bp.Query); mql == nil || !mql.Get("matches").Bool() {
+			continue
+		}
+		if val, exists := lookup(bp.Name); exists {
+			out, ok = val, true
+		}
+	}
+	return out, ok
+}
+
+// UseBreakpoint is a hook that returns the name of the current breakpoint (or BaseBreakpoint).
+// The component re-renders when the viewport crosses a breakpoint, so that fields with the
+// "media" tag option are resolved again.
+//
+// Example:
+//
+//  type GridProps struct {
+//      Columns map[string]interface{} `react:"columns,media"`
+//  }
+//
+//  react.UseBreakpoint()
+//  react.JSX(Grid, &GridProps{Columns: map[string]interface{}{"base": 1, "md": 2, "xl": 4}})
+//
+func UseBreakpoint() string {
+
+	if IsNative() {
+		return BaseBreakpoint
+	}
+
+	current, setCurrent := useState(currentBreakpoint())
+
+	useEffect(func() func() {
+		onChange := func() {
+			setCurrent(currentBreakpoint())
+		}
+
+		mqls := []*js.Object{}
+		for _, bp := range breakpoints {
+			if mql := mediaQueryList(bp.Query); mql != nil {
+				mqls = append(mqls, mql)
+				mql.Call("addListener", onChange)
+			}
+		}
+
+		// The viewport may have changed before the effect ran
+		onChange()
+
+		return func() {
+			for _, mql := range mqls {
+				mql.Call("removeListener", onChange)
+			}
+		}
+	}, []interface{}{})
+
+	return current.String()
+}
+
+// MediaQueryListEvent is provided to the listeners of a MediaQueryListState.
+type MediaQueryListEvent struct {
+	Matches bool   `react:"matches"`
+	Media   string `react:"media"`
+}
+
+// MediaQueryListState is returned by UseMediaQueryList.
+type MediaQueryListState struct {
+	Matches bool `react:"matches"`
+
+	// Media is the query as serialized by the browser.
+	Media string `react:"media"`
+
+	// AddListener adds fn, which is called (before the component re-renders) when the
+	// query starts or stops matching. It must be called while rendering, since the
+	// listeners are replaced on every render. They are removed when the component unmounts.
+	AddListener func(fn func(MediaQueryListEvent)) `react:"-"`
+}
+
+// UseMediaQueryList is a hook that returns the state of a media query. The component
+// re-renders when the query starts or stops matching. On the server (and in React Native),
+// Matches is false.
+//
+// The listeners can be used to start a transition (eg. animating a value) when the
+// viewport changes, instead of only switching on the next render.
+//
+// Example:
+//
+//  mql := react.UseMediaQueryList("(prefers-reduced-motion: reduce)")
+//  mql.AddListener(func(e react.MediaQueryListEvent) {
+//      animateTo(e.Matches)
+//  })
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/MediaQueryList
+func UseMediaQueryList(query string) MediaQueryListState {
+
+	if IsNative() {
+		return MediaQueryListState{Media: query, AddListener: func(fn func(MediaQueryListEvent)) {}}
+	}
+
+	type mediaQueryListState struct {
+		listeners []func(MediaQueryListEvent)
+		version   int
+	}
+
+	st := useGoRef(func() interface{} {
+		return &mediaQueryListState{}
+	}).(*mediaQueryListState)
+	st.listeners = nil
+
+	_, setVersion := useState(0)
+
+	mql := mediaQueryList(query)
+
+	useEffect(func() func() {
+		if mql == nil {
+			return nil
+		}
+
+		onChange := func(e *js.Object) {
+			ev := MediaQueryListEvent{Matches: e.Get("matches").Bool(), Media: e.Get("media").String()}
+			for _, fn := range st.listeners {
+				fn(ev)
+			}
+			st.version++
+			setVersion(st.version)
+		}
+
+		mql.Call("addListener", onChange)
+		return func() {
+			mql.Call("removeListener", onChange)
+		}
+	}, []interface{}{query})
+
+	state := MediaQueryListState{
+		Media: query,
+		AddListener: func(fn func(MediaQueryListEvent)) {
+			st.listeners = append(st.listeners, fn)
+		},
+	}
+
+	if mql != nil {
+		state.Matches = mql.Get("matches").Bool()
+		state.Media = mql.Get("media").String()
+	}
+
+	return state
+}
+
+// UseMediaQuery is a hook that returns true if the media query matches.
+// The component re-renders when it starts or stops matching.
+//
+// Example:
+//
+//  isDark := react.UseMediaQuery("(prefers-color-scheme: dark)")
+//
+func UseMediaQuery(query string) bool {
+	return UseMediaQueryList(query).Matches
+}
@@ -0,0 +1,223 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// MemoCompare generates a comparison function for React.memo. Only fields with the
+// "memo" tag option are compared. Other fields (eg. callbacks) are ignored, so
+// a component can skip re-rendering when only they change.
+// It returns true if all the compared fields are equal.
+//
+// T must be a struct or a pointer to a struct. A nil pointer is only equal to another nil pointer.
+//
+// Example:
+//
+//  type Props struct {
+//      Title   string     `react:"title,memo"`
+//      OnClick *js.Object `react:"onClick"`
+//  }
+//
+//  equal := react.MemoCompare[Props]()
+//
+//  react.React.Call("memo", component, func(prev, next *js.Object) bool {
+//      p, _ := react.UnmarshalStructAs[Props](prev.Interface().(map[string]interface{}))
+//      n, _ := react.UnmarshalStructAs[Props](next.Interface().(map[string]interface{}))
+//      return equal(p, n)
+//  })
+//
+func MemoCompare[T any]() func(prev, next T) bool {
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	ptr := t.Kind() == reflect.Ptr
+	if ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		panic("MemoCompare: T must be a struct")
+	}
+
+	indexes := []int{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// not exported
+			continue
+		}
+
+		if _, tagOpts := parseTag(f.Tag.Get("react")); tagOpts.has("memo") {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return func(prev, next T) bool {
+		p := reflect.ValueOf(&prev).Elem()
+		n := reflect.ValueOf(&next).Elem()
+
+		if ptr {
+			if p.IsNil() || n.IsNil() {
+				return p.IsNil() && n.IsNil()
+			}
+			p, n = p.Elem(), n.Elem()
+		}
+
+		for _, i := range indexes {
+			if !valuesEqual(p.Field(i).Interface(), n.Field(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PropsMemo converts props using SToMap and returns the previous result when nothing
+// has changed, so that the same map is returned across renders. A PropsMemo must not
+// be shared between components (see UsePropsMemo).
+//
+// Props must not be mutated after they are passed to Convert (just like React's props).
+type PropsMemo struct {
+	mu    sync.Mutex
+	props reflect.Value
+	out   map[string]interface{}
+}
+
+// NewPropsMemo returns a PropsMemo.
+func NewPropsMemo() *PropsMemo {
+	return &PropsMemo{}
+}
+
+// UsePropsMemo is a hook that returns a PropsMemo that persists for the
+// lifetime of the component.
+//
+// Example:
+//
+//  m := react.UsePropsMemo()
+//  return react.JSX(Chart, m.Convert(ChartProps{Data: data, OnSelect: onSelect}))
+//
+func UsePropsMemo() *PropsMemo {
+	return useGoRef(func() interface{} {
+		return NewPropsMemo()
+	}).(*PropsMemo)
+}
+
+// Convert converts props using SToMap. If props is equal to the props of the previous
+// call, the previous map is returned without converting props again. Otherwise, props is
+// converted and, if the result is equal to the previous map, the previous map is returned.
+//
+// Values are compared deeply, except that functions and *js.Object values are compared
+// by reference. Only the exported fields of structs are compared.
+func (m *PropsMemo) Convert(props interface{}) map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v := reflect.ValueOf(props)
+	if m.out != nil && propsEqual(m.props, v) {
+		return m.out
+	}
+	m.props = v
+
+	out := SToMap(props)
+	if m.out != nil && propsEqual(reflect.ValueOf(m.out), reflect.ValueOf(out)) {
+		return m.out
+	}
+	m.out = out
+	return out
+}
+
+// propsEqual reports whether a and b are deeply equal. Functions and *js.Object
+// values are compared by reference and unexported fields are ignored.
+func propsEqual(a, b reflect.Value) bool {
+
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	if a.Type() == jsObjectType {
+		return a.Interface().(*js.Object) == b.Interface().(*js.Object)
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Func:
+		return funcsEqual(a, b)
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() && b.IsNil()
+		}
+		return propsEqual(a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() {
+			return false
+		}
+		fallthrough
+	case reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !propsEqual(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() || a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			if !propsEqual(iter.Value(), b.MapIndex(iter.Key())) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		if a.Type() == reflect.TypeOf(time.Time{}) {
+			return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+		}
+		for i := 0; i < a.NumField(); i++ {
+			if a.Type().Field(i).PkgPath != "" {
+				// not exported
+				continue
+			}
+			if !propsEqual(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Channels and unsafe pointers
+	return a.Pointer() == b.Pointer()
+}
+
+// funcsEqual reports whether a and b are the same function. reflect.Value.Pointer
+// can't be used since GopherJS returns the same value for all functions.
+func funcsEqual(a, b reflect.Value) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() && b.IsNil()
+	}
+	return js.InternalObject(a.Interface()).Get("$val") == js.InternalObject(b.Interface()).Get("$val")
+}
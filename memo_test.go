@@ -0,0 +1,96 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+type memoProps struct {
+	Title   string            `react:"title"`
+	Tags    []string          `react:"tags"`
+	Style   map[string]string `react:"style"`
+	Ref     *js.Object        `react:"ref"`
+	OnClick func()            `react:"onClick"`
+}
+
+func TestPropsMemo(t *testing.T) {
+
+	onClick := func() {}
+	ref := js.Global.Get("Object").New()
+
+	props := func() memoProps {
+		return memoProps{
+			Title:   "title",
+			Tags:    []string{"a", "b"},
+			Style:   map[string]string{"color": "red"},
+			Ref:     ref,
+			OnClick: onClick,
+		}
+	}
+
+	m := NewPropsMemo()
+	first := m.Convert(props())
+
+	// Equal props (with new slices and maps) return the same map
+	if second := m.Convert(props()); !sameMap(first, second) {
+		t.Errorf("expected the same map for equal props")
+	}
+
+	changes := []func(p *memoProps){
+		func(p *memoProps) { p.Title = "other" },
+		func(p *memoProps) { p.Tags[1] = "c" },
+		func(p *memoProps) { p.Style["color"] = "blue" },
+		func(p *memoProps) { p.Ref = js.Global.Get("Object").New() },
+		func(p *memoProps) { p.OnClick = func() {} },
+	}
+	for i, change := range changes {
+		m := NewPropsMemo()
+		first := m.Convert(props())
+
+		p := props()
+		change(&p)
+		if second := m.Convert(p); sameMap(first, second) {
+			t.Errorf("%d: expected a new map for changed props", i)
+		}
+	}
+}
+
+// sameMap reports whether a and b are the same map instance.
+func sameMap(a, b map[string]interface{}) bool {
+	a["__sameMap"] = true
+	defer delete(a, "__sameMap")
+	_, same := b["__sameMap"]
+	return same
+}
+
+func BenchmarkPropsMemo(b *testing.B) {
+
+	onClick := func() {}
+	props := memoProps{
+		Title:   "title",
+		Tags:    []string{"a", "b", "c"},
+		Style:   map[string]string{"color": "red"},
+		OnClick: onClick,
+	}
+
+	b.Run("SToMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			SToMap(props)
+		}
+	})
+
+	b.Run("Convert", func(b *testing.B) {
+		m := NewPropsMemo()
+		for i := 0; i < b.N; i++ {
+			m.Convert(props)
+		}
+	})
+}
@@ -0,0 +1,339 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	// ErrChannelClosed is returned when a MessageChannelHelper is used after it is closed.
+	ErrChannelClosed = errors.New("react: message channel closed")
+
+	// ErrRequestTimeout is returned by MessageChannelHelper.Request when
+	// no response is received in time.
+	ErrRequestTimeout = errors.New("react: message channel request timed out")
+)
+
+// messageChannelKey identifies the messages sent by MessageChannelHelper.
+const messageChannelKey = "__reactChannel"
+
+const (
+	channelHello    = "hello"
+	channelReady    = "ready"
+	channelMessage  = "message"
+	channelRequest  = "request"
+	channelResponse = "response"
+)
+
+// ChannelMessage is provided to the handlers added with MessageChannelHelper.OnMessage.
+type ChannelMessage struct {
+	Topic   string
+	Payload *js.Object
+
+	// Origin is the verified origin of the sender.
+	Origin string
+
+	c  *MessageChannelHelper
+	id string // id of the request (empty for messages sent with Send)
+}
+
+// Reply sends payload as the response to a message sent with Request.
+// It does nothing for messages sent with Send.
+func (m *ChannelMessage) Reply(payload interface{}) error {
+	if m.id == "" {
+		return nil
+	}
+	return m.c.post(channelResponse, m.Topic, m.id, payload, "")
+}
+
+// ReplyError sends err as the response to a message sent with Request.
+// Request returns an error with the same message.
+func (m *ChannelMessage) ReplyError(err error) error {
+	if m.id == "" {
+		return nil
+	}
+	return m.c.post(channelResponse, m.Topic, m.id, nil, err.Error())
+}
+
+type channelResponseResult struct {
+	payload *js.Object
+	err     error
+}
+
+// MessageChannelHelper sends and receives messages to and from another window (eg. an iframe
+// or the parent window) using postMessage. Both windows must use a MessageChannelHelper.
+//
+// The windows perform a handshake when they connect. Messages sent before the other window
+// is ready (eg. while the iframe is still loading) are queued and sent once it is.
+type MessageChannelHelper struct {
+	target       *js.Object
+	targetOrigin string
+
+	ready  bool
+	closed bool
+	queue  []*js.Object // messages waiting for the handshake
+
+	handlers  map[string]map[int]func(*ChannelMessage)
+	nextSubID int
+
+	nextRequestID int
+	pending       map[string]chan channelResponseResult
+
+	listener func(e *js.Object)
+}
+
+// Connect creates a MessageChannelHelper for target (eg. iframe.contentWindow or window.parent).
+// Messages are only sent to (and accepted from) targetOrigin (eg. "https://partner.example.com").
+// A targetOrigin of "*" accepts messages from any origin, so it should only be used for
+// messages that aren't sensitive.
+//
+// Example:
+//
+//  ch := react.Connect(iframe.Get("contentWindow"), "https://partner.example.com")
+//  defer ch.Close()
+//
+//  ch.OnMessage("resize", func(m *react.ChannelMessage) {
+//      setHeight(m.Payload.Get("height").Int())
+//  })
+//
+//  go func() {
+//      user, err := ch.Request("getUser", nil, 5*time.Second)
+//  }()
+//
+func Connect(target *js.Object, targetOrigin string) *MessageChannelHelper {
+
+	c := &MessageChannelHelper{
+		target:       target,
+		targetOrigin: targetOrigin,
+		handlers:     map[string]map[int]func(*ChannelMessage){},
+		pending:      map[string]chan channelResponseResult{},
+	}
+
+	c.listener = func(e *js.Object) {
+		c.receive(e)
+	}
+	js.Global.Call("addEventListener", "message", c.listener)
+
+	c.postRaw(c.envelope(channelHello, "", "", nil, ""))
+	return c
+}
+
+// UseMessageChannel is a hook that returns a MessageChannelHelper for target while the
+// component is mounted (see Connect). It returns nil until the component mounts (or if target is nil).
+// The channel is closed when the component unmounts or target changes.
+func UseMessageChannel(target *js.Object, targetOrigin string) *MessageChannelHelper {
+
+	type messageChannelState struct {
+		c       *MessageChannelHelper
+		version int
+	}
+
+	st := useGoRef(func() interface{} {
+		return &messageChannelState{}
+	}).(*messageChannelState)
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		if target == nil || target == js.Undefined {
+			return nil
+		}
+
+		c := Connect(target, targetOrigin)
+		st.c = c
+		st.version++
+		setVersion(st.version)
+
+		return func() {
+			c.Close()
+			if st.c == c {
+				st.c = nil
+			}
+		}
+	}, []interface{}{target, targetOrigin})
+
+	return st.c
+}
+
+// OnMessage calls fn when a message with topic is received from the target.
+// The returned function removes fn.
+func (c *MessageChannelHelper) OnMessage(topic string, fn func(m *ChannelMessage)) (unsubscribe func()) {
+	if c.handlers[topic] == nil {
+		c.handlers[topic] = map[int]func(*ChannelMessage){}
+	}
+
+	c.nextSubID++
+	id := c.nextSubID
+	c.handlers[topic][id] = fn
+
+	return func() {
+		delete(c.handlers[topic], id)
+	}
+}
+
+// Send sends payload to the target's handlers of topic. payload is converted using
+// SerializeCloneable. If the target is not ready, the message is queued.
+func (c *MessageChannelHelper) Send(topic string, payload interface{}) error {
+	return c.post(channelMessage, topic, "", payload, "")
+}
+
+// Request is the same as Send except that it waits for a handler of the target to
+// reply (see ChannelMessage.Reply). ErrRequestTimeout is returned if there is no reply
+// within timeout (the time spent waiting for the handshake is included).
+//
+// NOTE: It must be called from a goroutine.
+func (c *MessageChannelHelper) Request(topic string, payload interface{}, timeout time.Duration) (*js.Object, error) {
+
+	c.nextRequestID++
+	id := strconv.Itoa(c.nextRequestID)
+
+	ch := make(chan channelResponseResult, 1)
+	c.pending[id] = ch
+
+	if err := c.post(channelRequest, topic, id, payload, ""); err != nil {
+		delete(c.pending, id)
+		return nil, err
+	}
+
+	select {
+	case res := <-ch:
+		return res.payload, res.err
+	case <-time.After(timeout):
+		delete(c.pending, id)
+		return nil, ErrRequestTimeout
+	}
+}
+
+// Close removes the listener and fails the requests that are waiting for a reply.
+func (c *MessageChannelHelper) Close() {
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.queue = nil
+
+	js.Global.Call("removeEventListener", "message", c.listener)
+
+	for id, ch := range c.pending {
+		ch <- channelResponseResult{err: ErrChannelClosed}
+		delete(c.pending, id)
+	}
+}
+
+func (c *MessageChannelHelper) envelope(typ, topic, id string, payload interface{}, errMsg string) *js.Object {
+	msg := js.Global.Get("Object").New()
+	msg.Set(messageChannelKey, 1)
+	msg.Set("type", typ)
+	msg.Set("topic", topic)
+	msg.Set("id", id)
+	msg.Set("payload", payload)
+	if errMsg != "" {
+		msg.Set("error", errMsg)
+	}
+	return msg
+}
+
+// post sends a message, or queues it until the target is ready.
+func (c *MessageChannelHelper) post(typ, topic, id string, payload interface{}, errMsg string) error {
+	if c.closed {
+		return ErrChannelClosed
+	}
+
+	cloned, err := SerializeCloneable(payload)
+	if err != nil {
+		return err
+	}
+
+	msg := c.envelope(typ, topic, id, cloned, errMsg)
+	if !c.ready {
+		c.queue = append(c.queue, msg)
+		return nil
+	}
+
+	c.postRaw(msg)
+	return nil
+}
+
+func (c *MessageChannelHelper) postRaw(msg *js.Object) {
+	defer func() {
+		// The target may have been closed or navigated away
+		if r := recover(); r != nil {
+			if _, ok := r.(*js.Error); !ok {
+				panic(r)
+			}
+		}
+	}()
+	c.target.Call("postMessage", msg, c.targetOrigin)
+}
+
+// setReady sends the queued messages.
+func (c *MessageChannelHelper) setReady() {
+	if c.ready {
+		return
+	}
+	c.ready = true
+
+	queue := c.queue
+	c.queue = nil
+	for _, msg := range queue {
+		c.postRaw(msg)
+	}
+}
+
+// receive handles a message event. Messages from other windows or origins are ignored.
+func (c *MessageChannelHelper) receive(e *js.Object) {
+
+	if e.Get("source") != c.target {
+		return
+	}
+
+	origin := e.Get("origin").String()
+	if c.targetOrigin != "*" && origin != c.targetOrigin {
+		return
+	}
+
+	data := e.Get("data")
+	if data == nil || data == js.Undefined || data.Get(messageChannelKey) == js.Undefined {
+		return
+	}
+
+	topic := data.Get("topic").String()
+	id := data.Get("id").String()
+
+	switch data.Get("type").String() {
+	case channelHello:
+		// The target has (re)loaded
+		c.postRaw(c.envelope(channelReady, "", "", nil, ""))
+		c.setReady()
+	case channelReady:
+		c.setReady()
+	case channelMessage, channelRequest:
+		// The target is obviously ready
+		c.setReady()
+
+		if data.Get("type").String() == channelMessage {
+			id = ""
+		}
+		m := &ChannelMessage{Topic: topic, Payload: data.Get("payload"), Origin: origin, c: c, id: id}
+		for _, fn := range c.handlers[topic] {
+			fn(m)
+		}
+	case channelResponse:
+		ch, exists := c.pending[id]
+		if !exists {
+			return
+		}
+		delete(c.pending, id)
+
+		if errMsg := data.Get("error"); errMsg != js.Undefined {
+			ch <- channelResponseResult{err: errors.New(errMsg.String())}
+		} else {
+			ch <- channelResponseResult{payload: data.Get("payload")}
+		}
+	}
+}
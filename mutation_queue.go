@@ -0,0 +1,296 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// QueuedMutation is a request that is waiting in a MutationQueue.
+type QueuedMutation struct {
+	ID       string            `react:"id"`
+	Method   string            `react:"method"`
+	URL      string            `react:"url"`
+	Headers  map[string]string `react:"headers,omitempty"`
+	Body     string            `react:"body,omitempty"` // json encoded
+	Attempts int               `react:"attempts"`
+}
+
+// MutationConflictError is provided to the failure callback when the server
+// responds with a 4xx status. The request is removed from the queue since
+// retrying will not help.
+type MutationConflictError struct {
+	Status   int
+	Response *js.Object
+}
+
+// Error implements the error interface.
+func (e *MutationConflictError) Error() string {
+	return "react: mutation rejected with status " + strconv.Itoa(e.Status)
+}
+
+// MutationTransientError is provided to the failure callback when a request
+// has failed due to a network error or a 5xx status more than MaxAttempts times.
+type MutationTransientError struct {
+	Status int // 0 for network errors
+	Err    error
+}
+
+// Error implements the error interface.
+func (e *MutationTransientError) Error() string {
+	if e.Err != nil {
+		return "react: mutation failed: " + e.Err.Error()
+	}
+	return "react: mutation failed with status " + strconv.Itoa(e.Status)
+}
+
+// MutationCallbacks are notified when a queued mutation completes.
+type MutationCallbacks struct {
+	OnSuccess func(response *js.Object)
+	OnFailure func(err error)
+}
+
+// MutationQueue persists mutations (POST, PUT, DELETE etc.) to localStorage while the
+// browser is offline and replays them in order when connectivity returns.
+// Transient failures (network errors and 5xx statuses) are retried with exponential backoff.
+//
+// NOTE: Callbacks are not persisted. Mutations that are restored after a page reload
+// are still replayed but without callbacks.
+type MutationQueue struct {
+	// MaxAttempts is the maximum number of attempts for transient failures.
+	// 0 means unlimited.
+	MaxAttempts int
+
+	// MaxBackoff caps the delay between attempts. The default is 1 minute.
+	MaxBackoff time.Duration
+
+	storageKey  string
+	items       []QueuedMutation
+	callbacks   map[string]MutationCallbacks
+	subscribers map[int]func()
+	nextSubID   int
+	draining    bool
+	seq         int
+}
+
+// NewMutationQueue creates a MutationQueue that persists to localStorage under storageKey.
+// Mutations persisted by a previous page load are restored and replayed when online.
+func NewMutationQueue(storageKey string) *MutationQueue {
+
+	q := &MutationQueue{
+		MaxBackoff:  time.Minute,
+		storageKey:  storageKey,
+		callbacks:   map[string]MutationCallbacks{},
+		subscribers: map[int]func(){},
+	}
+	q.load()
+
+	js.Global.Call("addEventListener", "online", func() {
+		q.drain()
+	})
+	q.drain()
+
+	return q
+}
+
+// Enqueue adds a mutation to the queue. body is json encoded using JSONMarshal.
+// The returned id identifies the mutation in Pending.
+func (q *MutationQueue) Enqueue(method, url string, body interface{}, headers map[string]string, cb ...MutationCallbacks) (string, error) {
+
+	m := QueuedMutation{
+		Method:  method,
+		URL:     url,
+		Headers: headers,
+	}
+
+	if body != nil {
+		b, err := JSONMarshal(body)
+		if err != nil {
+			return "", err
+		}
+		m.Body = b
+	}
+
+	q.seq++
+	m.ID = strconv.FormatInt(time.Now().UnixNano(), 36) + "-" + strconv.Itoa(q.seq)
+
+	if len(cb) > 0 {
+		q.callbacks[m.ID] = cb[0]
+	}
+
+	q.items = append(q.items, m)
+	q.save()
+	q.drain()
+
+	return m.ID, nil
+}
+
+// Len returns the number of mutations waiting in the queue.
+func (q *MutationQueue) Len() int {
+	return len(q.items)
+}
+
+// Pending returns a copy of the mutations waiting in the queue.
+func (q *MutationQueue) Pending() []QueuedMutation {
+	return append([]QueuedMutation{}, q.items...)
+}
+
+// Subscribe calls fn whenever the queue changes. The returned function unsubscribes.
+func (q *MutationQueue) Subscribe(fn func()) (unsubscribe func()) {
+	q.nextSubID++
+	id := q.nextSubID
+	q.subscribers[id] = fn
+	return func() {
+		delete(q.subscribers, id)
+	}
+}
+
+// UseMutationQueue is a hook that returns the number of mutations waiting in q
+// and the mutations themselves. The component re-renders when the queue changes.
+// It is useful for displaying a status badge.
+func UseMutationQueue(q *MutationQueue) (length int, pending []QueuedMutation) {
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		version := 0
+		return q.Subscribe(func() {
+			version++
+			setVersion(version)
+		})
+	}, []interface{}{q.storageKey})
+
+	return q.Len(), q.Pending()
+}
+
+// drain sends the queued mutations in order while the browser is online.
+func (q *MutationQueue) drain() {
+	if q.draining || len(q.items) == 0 || !js.Global.Get("navigator").Get("onLine").Bool() {
+		return
+	}
+	q.draining = true
+
+	go func() {
+		defer func() {
+			q.draining = false
+		}()
+
+		backoff := time.Second
+
+		for len(q.items) > 0 {
+			if !js.Global.Get("navigator").Get("onLine").Bool() {
+				// Resume when the online event fires
+				return
+			}
+
+			m := q.items[0]
+			cb := q.callbacks[m.ID]
+
+			resp, status, err := q.send(m)
+			switch {
+			case err == nil && status < 400:
+				q.remove(m.ID)
+				backoff = time.Second
+				if cb.OnSuccess != nil {
+					cb.OnSuccess(resp)
+				}
+				continue
+			case err == nil && status < 500:
+				q.remove(m.ID)
+				backoff = time.Second
+				if cb.OnFailure != nil {
+					cb.OnFailure(&MutationConflictError{Status: status, Response: resp})
+				}
+				continue
+			}
+
+			// Transient failure
+			q.items[0].Attempts++
+			q.save()
+
+			if q.MaxAttempts > 0 && q.items[0].Attempts >= q.MaxAttempts {
+				q.remove(m.ID)
+				if cb.OnFailure != nil {
+					cb.OnFailure(&MutationTransientError{Status: status, Err: err})
+				}
+				continue
+			}
+
+			time.Sleep(backoff)
+			backoff = backoff * 2
+			if backoff > q.MaxBackoff {
+				backoff = q.MaxBackoff
+			}
+		}
+	}()
+}
+
+// send performs the request using the native fetch function.
+func (q *MutationQueue) send(m QueuedMutation) (*js.Object, int, error) {
+
+	init := js.M{"method": m.Method}
+	if m.Headers != nil {
+		headers := js.M{}
+		for k, v := range m.Headers {
+			headers[k] = v
+		}
+		init["headers"] = headers
+	}
+	if m.Body != "" {
+		init["body"] = m.Body
+	}
+
+	resp, err := JSFnPromise("fetch", m.URL, init)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp, resp.Get("status").Int(), nil
+}
+
+func (q *MutationQueue) remove(id string) {
+	for i := range q.items {
+		if q.items[i].ID == id {
+			q.items = append(q.items[:i], q.items[i+1:]...)
+			break
+		}
+	}
+	delete(q.callbacks, id)
+	q.save()
+}
+
+// save persists the queue and notifies subscribers.
+func (q *MutationQueue) save() {
+	if s, err := JSONMarshal(q.items); err == nil {
+		js.Global.Get("localStorage").Call("setItem", q.storageKey, s)
+	}
+
+	for _, fn := range q.subscribers {
+		fn()
+	}
+}
+
+// load restores the queue from localStorage.
+func (q *MutationQueue) load() {
+	s := js.Global.Get("localStorage").Call("getItem", q.storageKey)
+	if s == nil {
+		return
+	}
+
+	arr, err := JSONUnmarshal(s.String())
+	if err != nil {
+		return
+	}
+
+	for i := 0; i < arr.Length(); i++ {
+		var m QueuedMutation
+		mp, ok := arr.Index(i).Interface().(map[string]interface{})
+		if !ok || UnmarshalStruct(mp, &m) != nil {
+			continue
+		}
+		q.items = append(q.items, m)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+)
+
+// ErrNotSupported is returned by browser-only functions when compiled
+// for React Native (with the native build tag).
+var ErrNotSupported = errors.New("react: not supported by react native")
+
+// IsNative returns true when compiled with the native build tag (for React Native).
+// Shared component libraries can use it to avoid browser-only features.
+//
+// When compiled for React Native, these browser-only functions are replaced with stubs
+// (or return early):
+//
+//  OnClickOutside, UseClickOutside                do nothing
+//  ObserveVisibility, TrackImpression             do nothing
+//  SaveScroll, RestoreScroll, UseScrollContainer  do nothing
+//  UseScrollRestoration, Print                    do nothing
+//  UsePrintMode                                   returns false
+//  PrintSection                                   renders nothing
+//  UseBreakpoint                                  returns BaseBreakpoint
+//  UseMediaQuery, UseMediaQueryList               never match
+//  LoadScript, LoadStylesheet, UseScript          return ErrNotSupported
+//  SSEFeed                                        renders nothing
+//  UseIDBQuery                                    returns ErrNotSupported (OpenDB returns ErrIDBUnavailable)
+//  GetSelection, RestoreSelection                 return ErrNotSupported
+//  OnSelectionChange                              does nothing
+//  CopyToClipboard                                returns ErrNotSupported
+//  the "hotkey" tag option                        is ignored
+//
+// Other browser-only functions (eg. GetFocusOrder) must not be used.
+func IsNative() bool {
+	return nativeBuild
+}
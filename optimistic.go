@@ -0,0 +1,280 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// OptimisticStore is a store of state that can be used with Optimistic
+// (instead of a class component). It must be comparable (eg. a pointer).
+type OptimisticStore interface {
+	// State returns the current state.
+	State() map[string]interface{}
+
+	// SetState merges state into the current state.
+	SetState(state map[string]interface{})
+}
+
+// OptimisticCallbacks are the functions that Optimistic uses to update the state.
+type OptimisticCallbacks struct {
+	// Apply makes the change to the state before the server responds.
+	Apply func(state map[string]interface{}) map[string]interface{}
+
+	// Commit sends the change to the server. It is called from a goroutine.
+	Commit func() (serverResult *js.Object, err error)
+
+	// Rollback undoes Apply. err is the error returned by Commit. err is nil if the
+	// change is temporarily undone so that an earlier change can be rolled back or
+	// reconciled (it is then applied again).
+	Rollback func(state map[string]interface{}, err error) map[string]interface{}
+
+	// Reconcile optionally updates the state with the server's result after
+	// Commit succeeds.
+	Reconcile func(state map[string]interface{}, result *js.Object) map[string]interface{}
+}
+
+// optimisticEntry is an update whose Commit is in progress.
+type optimisticEntry struct {
+	cb OptimisticCallbacks
+}
+
+// optimisticQueue stores the updates of a target whose Commits are in progress.
+type optimisticQueue struct {
+	pending []*optimisticEntry
+}
+
+// optimisticQueueKey stores the optimisticQueue of a class component.
+const optimisticQueueKey = "__optimisticQueue"
+
+// optimisticStoreQueues stores the optimisticQueues of OptimisticStores.
+var optimisticStoreQueues = map[OptimisticStore]*optimisticQueue{}
+
+// errUnsupportedOptimisticTarget is returned when the target of Optimistic is invalid.
+var errUnsupportedOptimisticTarget = errors.New("react: Optimistic: target must be a class component or an OptimisticStore")
+
+// optimisticTarget abstracts a class component and an OptimisticStore.
+type optimisticTarget struct {
+	queue    *optimisticQueue
+	state    func() map[string]interface{}
+	setState func(map[string]interface{})
+	mounted  func() bool
+}
+
+func newOptimisticTarget(target interface{}) (*optimisticTarget, error) {
+	switch t := target.(type) {
+	case OptimisticStore:
+		q := optimisticStoreQueues[t]
+		if q == nil {
+			q = &optimisticQueue{}
+			optimisticStoreQueues[t] = q
+		}
+		return &optimisticTarget{
+			queue:    q,
+			state:    t.State,
+			setState: t.SetState,
+			mounted:  func() bool { return true },
+		}, nil
+	case *js.Object:
+		if t == nil || t == js.Undefined {
+			return nil, errUnsupportedOptimisticTarget
+		}
+
+		var q *optimisticQueue
+		if w := t.Get(optimisticQueueKey); w != js.Undefined && w != nil {
+			q = w.Interface().(*optimisticQueue)
+		} else {
+			q = &optimisticQueue{}
+			t.Set(optimisticQueueKey, js.MakeWrapper(q))
+		}
+
+		return &optimisticTarget{
+			queue: q,
+			state: func() map[string]interface{} {
+				mp, _ := objectMap(t.Get("state"), "state")
+				return mp
+			},
+			setState: func(mp map[string]interface{}) {
+				t.Call("setState", mp)
+			},
+			mounted: func() bool {
+				updater := t.Get("updater")
+				if updater == js.Undefined || updater == nil || updater.Get("isMounted") == js.Undefined {
+					return true
+				}
+				return updater.Call("isMounted", t).Bool()
+			},
+		}, nil
+	}
+	return nil, errUnsupportedOptimisticTarget
+}
+
+// Optimistic applies a change to the state of target immediately and then sends it to
+// the server using cb.Commit (in a goroutine). If Commit fails, the change is undone using
+// cb.Rollback. If it succeeds, cb.Reconcile (if set) updates the state with the server's result.
+// target is either a class component's this or an OptimisticStore.
+//
+// Updates that overlap (ie. a Commit is in progress when another update is made) are queued.
+// When an update is rolled back (or reconciled), the updates made after it are first undone
+// in reverse order (using Rollback with a nil error) and then applied again, so that the
+// changes compose correctly. The state is set once per event and nothing is set if the
+// component has unmounted.
+//
+// Example:
+//
+//  react.Optimistic(this, react.OptimisticCallbacks{
+//      Apply: func(state map[string]interface{}) map[string]interface{} {
+//          state["likes"] = state["likes"].(float64) + 1
+//          return state
+//      },
+//      Commit: func() (*js.Object, error) {
+//          return react.JSFnPromise("fetch", "/api/like", js.M{"method": "POST"})
+//      },
+//      Rollback: func(state map[string]interface{}, err error) map[string]interface{} {
+//          state["likes"] = state["likes"].(float64) - 1
+//          return state
+//      },
+//  })
+//
+func Optimistic(target interface{}, cb OptimisticCallbacks) error {
+
+	t, err := newOptimisticTarget(target)
+	if err != nil {
+		return err
+	}
+
+	u := &optimisticEntry{cb: cb}
+	t.queue.pending = append(t.queue.pending, u)
+
+	t.update(func(state map[string]interface{}) map[string]interface{} {
+		return cb.Apply(state)
+	})
+
+	go func() {
+		res, err := cb.Commit()
+		t.settle(u, res, err)
+	}()
+
+	return nil
+}
+
+// update sets the state to the result of fn (if the component is still mounted).
+func (t *optimisticTarget) update(fn func(state map[string]interface{}) map[string]interface{}) {
+	if !t.mounted() {
+		return
+	}
+
+	state := fn(copyMap(t.state()))
+	BatchedUpdates(func() {
+		t.setState(state)
+	})
+}
+
+// settle removes u from the queue and rolls it back (or reconciles it).
+func (t *optimisticTarget) settle(u *optimisticEntry, res *js.Object, err error) {
+
+	q := t.queue
+
+	idx := -1
+	for i := range q.pending {
+		if q.pending[i] == u {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return
+	}
+
+	later := append([]*optimisticEntry(nil), q.pending[idx+1:]...)
+	q.pending = append(q.pending[:idx:idx], q.pending[idx+1:]...)
+
+	if err == nil && u.cb.Reconcile == nil {
+		// Nothing to change
+		return
+	}
+
+	t.update(func(state map[string]interface{}) map[string]interface{} {
+		// Undo the later updates in reverse order
+		for i := len(later) - 1; i >= 0; i-- {
+			state = later[i].cb.Rollback(state, nil)
+		}
+
+		if err != nil {
+			state = u.cb.Rollback(state, err)
+		} else {
+			state = u.cb.Reconcile(state, res)
+		}
+
+		// Apply the later updates again
+		for _, l := range later {
+			state = l.cb.Apply(state)
+		}
+		return state
+	})
+}
+
+// copyMap returns a shallow copy of mp, so that the callbacks
+// can modify the state that they are provided.
+func copyMap(mp map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(mp))
+	for k, v := range mp {
+		out[k] = v
+	}
+	return out
+}
+
+// OptimisticCallbacksAs is the same as OptimisticCallbacks except that the state is
+// the struct S. See OptimisticAs.
+type OptimisticCallbacksAs[S any] struct {
+	Apply     func(state S) S
+	Commit    func() (serverResult *js.Object, err error)
+	Rollback  func(state S, err error) S
+	Reconcile func(state S, result *js.Object) S
+}
+
+// OptimisticAs is the same as Optimistic except that the state is converted to the
+// struct S (using UnmarshalStruct) before it is provided to the callbacks, and the
+// results are converted back using SToMap.
+//
+// Example:
+//
+//  type PostState struct {
+//      Likes int `react:"likes"`
+//  }
+//
+//  react.OptimisticAs(this, react.OptimisticCallbacksAs[PostState]{
+//      Apply:    func(s PostState) PostState { s.Likes++; return s },
+//      Commit:   like,
+//      Rollback: func(s PostState, err error) PostState { s.Likes--; return s },
+//  })
+//
+func OptimisticAs[S any](target interface{}, cb OptimisticCallbacksAs[S]) error {
+
+	wrap := func(fn func(S) S) func(map[string]interface{}) map[string]interface{} {
+		return func(state map[string]interface{}) map[string]interface{} {
+			var s S
+			if err := UnmarshalStruct(state, &s); err != nil {
+				panic(err)
+			}
+			return SToMap(fn(s))
+		}
+	}
+
+	out := OptimisticCallbacks{
+		Apply:  wrap(cb.Apply),
+		Commit: cb.Commit,
+		Rollback: func(state map[string]interface{}, err error) map[string]interface{} {
+			return wrap(func(s S) S { return cb.Rollback(s, err) })(state)
+		},
+	}
+	if cb.Reconcile != nil {
+		out.Reconcile = func(state map[string]interface{}, result *js.Object) map[string]interface{} {
+			return wrap(func(s S) S { return cb.Reconcile(s, result) })(state)
+		}
+	}
+
+	return Optimistic(target, out)
+}
@@ -0,0 +1,154 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+type optimisticOpKind int
+
+const (
+	optimisticAdd optimisticOpKind = iota
+	optimisticUpdate
+	optimisticRemove
+)
+
+// optimisticOp is a change that is waiting for its operation to complete.
+type optimisticOp[T any] struct {
+	id   int
+	kind optimisticOpKind
+	key  string
+	item T
+}
+
+// apply returns a copy of list with the change applied.
+func (op optimisticOp[T]) apply(list []T, keyFn func(T) string) []T {
+
+	out := make([]T, 0, len(list)+1)
+
+	switch op.kind {
+	case optimisticAdd:
+		out = append(out, list...)
+		out = append(out, op.item)
+	case optimisticUpdate:
+		for _, item := range list {
+			if keyFn(item) == op.key {
+				item = op.item
+			}
+			out = append(out, item)
+		}
+	case optimisticRemove:
+		for _, item := range list {
+			if keyFn(item) != op.key {
+				out = append(out, item)
+			}
+		}
+	}
+
+	return out
+}
+
+type optimisticListState[T any] struct {
+	confirmed  []T               // list without pending changes
+	pending    []optimisticOp[T] // in the order they were made
+	keyFn      func(T) string
+	nextID     int
+	unmounted  bool
+	version    int
+	setVersion func(interface{})
+}
+
+// list returns the confirmed list with the pending changes applied.
+func (st *optimisticListState[T]) list() []T {
+	list := st.confirmed
+	for _, op := range st.pending {
+		list = op.apply(list, st.keyFn)
+	}
+	return list
+}
+
+func (st *optimisticListState[T]) rerender() {
+	if st.unmounted {
+		return
+	}
+	st.version++
+	st.setVersion(st.version)
+}
+
+// run shows op immediately and runs operation in a goroutine. If operation
+// returns an error, op is discarded.
+func (st *optimisticListState[T]) run(op optimisticOp[T], operation func() error) {
+
+	st.nextID++
+	op.id = st.nextID
+	st.pending = append(st.pending, op)
+	st.rerender()
+
+	go func() {
+		err := operation()
+
+		for i := range st.pending {
+			if st.pending[i].id == op.id {
+				st.pending = append(st.pending[:i:i], st.pending[i+1:]...)
+				break
+			}
+		}
+
+		if err == nil {
+			st.confirmed = op.apply(st.confirmed, st.keyFn)
+		}
+		st.rerender()
+	}()
+}
+
+// OptimisticListOps changes the list returned by UseOptimisticList.
+type OptimisticListOps[T any] struct {
+	st *optimisticListState[T]
+}
+
+// AddOptimistic appends item to the list while operation runs.
+func (o OptimisticListOps[T]) AddOptimistic(item T, operation func() error) {
+	o.st.run(optimisticOp[T]{kind: optimisticAdd, item: item}, operation)
+}
+
+// UpdateOptimistic replaces the item with key while operation runs.
+func (o OptimisticListOps[T]) UpdateOptimistic(key string, item T, operation func() error) {
+	o.st.run(optimisticOp[T]{kind: optimisticUpdate, key: key, item: item}, operation)
+}
+
+// RemoveOptimistic removes the item with key while operation runs.
+func (o OptimisticListOps[T]) RemoveOptimistic(key string, operation func() error) {
+	o.st.run(optimisticOp[T]{kind: optimisticRemove, key: key}, operation)
+}
+
+// UseOptimisticList is a hook that manages a list whose changes are shown immediately,
+// before the operation that performs them (eg. a request to the server) completes.
+// keyFn returns the unique key of an item. initial is only used when the component mounts.
+//
+// Each operation runs in a goroutine. If it returns an error, its change is rolled back.
+// Pending changes are kept separately from the confirmed list (and applied in order when
+// rendering), so a rollback restores the exact previous order without undoing other changes.
+// The operation should report its error to the user itself.
+//
+// Example:
+//
+//  todos, ops := react.UseOptimisticList(initialTodos, func(t Todo) string { return t.ID })
+//
+//  ops.RemoveOptimistic(todo.ID, func() error {
+//      return api.DeleteTodo(todo.ID)
+//  })
+//
+func UseOptimisticList[T any](initial []T, keyFn func(T) string) ([]T, OptimisticListOps[T]) {
+
+	st := useGoRef(func() interface{} {
+		return &optimisticListState[T]{confirmed: append([]T(nil), initial...)}
+	}).(*optimisticListState[T])
+	st.keyFn = keyFn
+
+	_, st.setVersion = useState(0)
+
+	useEffect(func() func() {
+		return func() {
+			st.unmounted = true
+		}
+	}, []interface{}{})
+
+	return st.list(), OptimisticListOps[T]{st}
+}
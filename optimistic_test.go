@@ -0,0 +1,93 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+type testOptimisticStore struct {
+	state   map[string]interface{}
+	updated chan struct{}
+}
+
+func (s *testOptimisticStore) State() map[string]interface{} {
+	return s.state
+}
+
+func (s *testOptimisticStore) SetState(state map[string]interface{}) {
+	s.state = state
+	s.updated <- struct{}{}
+}
+
+func TestOptimisticOverlappingFailure(t *testing.T) {
+
+	store := &testOptimisticStore{
+		state:   map[string]interface{}{"count": 0},
+		updated: make(chan struct{}, 10),
+	}
+
+	add := func(n int, result chan error) OptimisticCallbacks {
+		return OptimisticCallbacks{
+			Apply: func(state map[string]interface{}) map[string]interface{} {
+				state["count"] = state["count"].(int) + n
+				return state
+			},
+			Commit: func() (*js.Object, error) {
+				return nil, <-result
+			},
+			Rollback: func(state map[string]interface{}, err error) map[string]interface{} {
+				state["count"] = state["count"].(int) - n
+				return state
+			},
+		}
+	}
+
+	first := make(chan error)
+	second := make(chan error)
+
+	if err := Optimistic(store, add(1, first)); err != nil {
+		t.Fatal(err)
+	}
+	<-store.updated
+	if err := Optimistic(store, add(10, second)); err != nil {
+		t.Fatal(err)
+	}
+	<-store.updated
+
+	if got := store.state["count"]; got != 11 {
+		t.Fatalf("expected 11 after applying both updates, got %v", got)
+	}
+
+	// The first update fails while the second is still pending
+	first <- errors.New("failed")
+	<-store.updated
+	if got := store.state["count"]; got != 10 {
+		t.Errorf("expected 10 after rolling back the first update, got %v", got)
+	}
+
+	// The second update fails
+	second <- errors.New("failed")
+	<-store.updated
+	if got := store.state["count"]; got != 0 {
+		t.Errorf("expected 0 after rolling back both updates, got %v", got)
+	}
+
+	if len(optimisticStoreQueues[store].pending) != 0 {
+		t.Errorf("expected the queue to be empty")
+	}
+}
+
+func TestOptimisticInvalidTarget(t *testing.T) {
+	if err := Optimistic(5, OptimisticCallbacks{}); err == nil {
+		t.Errorf("expected an error for an invalid target")
+	}
+}
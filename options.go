@@ -0,0 +1,157 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+)
+
+// Option is used to control how SToMap converts a struct.
+type Option func(*options)
+
+const (
+	// zeroValuesTag means omitempty in the tag decides.
+	zeroValuesTag = iota
+	// zeroValuesKeep means zero values are always kept.
+	zeroValuesKeep
+	// zeroValuesDrop means zero values are always dropped.
+	zeroValuesDrop
+)
+
+type options struct {
+	tagNames   []string
+	zeroValues int
+	camelCase  bool
+	omitFunc   func(fieldName string, value interface{}) bool
+
+	// elideUncloneable is used by SerializeCloneable
+	elideUncloneable bool
+
+	// setPath and setRoot are used to convert Sets inside nested structs.
+	// setPath is the path of the nested struct (eg. "style.") and setRoot
+	// is the top-level map.
+	setPath string
+	setRoot map[string]interface{}
+}
+
+// defaultOptions is what SToMap uses when no options are provided.
+var defaultOptions = &options{
+	tagNames: []string{"react"},
+}
+
+func newOptions(opts []Option) *options {
+	if len(opts) == 0 {
+		return defaultOptions
+	}
+
+	o := &options{
+		tagNames: []string{"react"},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithTagName sets the struct tag used to name fields. The default is "react".
+func WithTagName(name string) Option {
+	return func(o *options) {
+		o.tagNames[0] = name
+	}
+}
+
+// WithFallbackTagName adds a struct tag that is used when a field
+// does not have the main tag. Multiple fallbacks are tried in the order provided.
+//
+// This is useful for structs that are already tagged for encoding/json.
+// A fallback tag of "-" omits the field, and an empty name (eg. `json:",omitempty"`)
+// uses the field's name. Options that SToMap doesn't recognize (eg. string) are ignored.
+//
+// Example:
+//
+//  // Try the react tag, followed by the json tag
+//  react.SToMap(props, react.WithFallbackTagName("json"))
+//
+func WithFallbackTagName(name string) Option {
+	return func(o *options) {
+		o.tagNames = append(o.tagNames, name)
+	}
+}
+
+// WithZeroValues overrides omitempty for all fields. If keep is true, zero values
+// are always kept. If keep is false, zero values are always dropped.
+func WithZeroValues(keep bool) Option {
+	return func(o *options) {
+		if keep {
+			o.zeroValues = zeroValuesKeep
+		} else {
+			o.zeroValues = zeroValuesDrop
+		}
+	}
+}
+
+// WithCamelCase names fields that have no tag by lowercasing the first letter of
+// the field's name (eg. BackgroundColor becomes backgroundColor). Fields with a tag
+// (including a tag with only options, such as ",omitempty") keep their names.
+//
+// Only the first letter is changed, so acronyms are not fully lowercased
+// (eg. URLPath becomes uRLPath and ID becomes iD). Use a tag for those fields.
+//
+// Example:
+//
+//  react.SToMap(props, react.WithCamelCase())
+//
+func WithCamelCase() Option {
+	return func(o *options) {
+		o.camelCase = true
+	}
+}
+
+// WithOmitFunc omits the fields for which fn returns true, regardless of their tags. fn is called
+// with the Go name of the field (eg. "Disabled") and the field's value. It is called for the
+// exported fields (including those of embedded and nested structs) that are not already
+// omitted by omitempty (or WithZeroValues).
+//
+// Example:
+//
+//  // Omit Disabled when it's false (a *bool is kept when it's set)
+//  react.SToMap(props, react.WithOmitFunc(func(fieldName string, value interface{}) bool {
+//      return fieldName == "Disabled" && value == false
+//  }))
+//
+func WithOmitFunc(fn func(fieldName string, value interface{}) bool) Option {
+	return func(o *options) {
+		o.omitFunc = fn
+	}
+}
+
+// tag returns the tag for a field, using the first tag name that is present.
+func (o *options) tag(f reflect.StructField) string {
+	for _, name := range o.tagNames {
+		if tag, exists := f.Tag.Lookup(name); exists {
+			return tag
+		}
+	}
+	return ""
+}
+
+// omitEmpty returns true if a zero value should be omitted for a field.
+// tagged reports whether the field's tag contains omitempty.
+func (o *options) omitEmpty(tagged bool) bool {
+	switch o.zeroValues {
+	case zeroValuesKeep:
+		return false
+	case zeroValuesDrop:
+		return true
+	default:
+		return tagged
+	}
+}
+
+// WithElideUncloneable is used by SerializeCloneable to drop values that can't be
+// cloned (eg. functions and DOM nodes) instead of returning an error.
+func WithElideUncloneable() Option {
+	return func(o *options) {
+		o.elideUncloneable = true
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SToJSONPatch converts oldS and newS using SToMap and returns the RFC 6902
+// operations (add, remove and replace) required to transform the old props into the new props.
+// The operations can be consumed by javascript libraries such as fast-json-patch.
+//
+// Nested objects are diffed recursively. Arrays that have changed are replaced entirely.
+//
+// Example:
+//
+//  ops, err := react.SToJSONPatch(oldProps, newProps)
+//  // [{"op": "replace", "path": "/title", "value": "New title"}]
+//
+// See: https://tools.ietf.org/html/rfc6902
+func SToJSONPatch(oldS, newS interface{}, opts ...Option) ([]map[string]interface{}, error) {
+
+	if !isStruct(oldS) || !isStruct(newS) {
+		return nil, errors.New("SToJSONPatch: arguments must be structs")
+	}
+
+	o := newOptions(opts)
+	ops := []map[string]interface{}{}
+	diffMaps("", convertStruct(oldS, o), convertStruct(newS, o), &ops)
+	return ops, nil
+}
+
+// jsonPointerEscaper escapes a key for use as a JSON pointer reference token.
+//
+// See: https://tools.ietf.org/html/rfc6901
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// diffMaps appends the operations that transform a into b. Keys are
+// visited in sorted order so the output is deterministic.
+func diffMaps(path string, a, b map[string]interface{}, ops *[]map[string]interface{}) {
+
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, exists := a[k]; !exists {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := path + "/" + jsonPointerEscaper.Replace(k)
+		aVal, inA := a[k]
+		bVal, inB := b[k]
+
+		switch {
+		case !inB:
+			*ops = append(*ops, map[string]interface{}{"op": "remove", "path": p})
+		case !inA:
+			*ops = append(*ops, map[string]interface{}{"op": "add", "path": p, "value": bVal})
+		default:
+			aMap, aIsMap := aVal.(map[string]interface{})
+			bMap, bIsMap := bVal.(map[string]interface{})
+			if aIsMap && bIsMap && aMap != nil && bMap != nil {
+				diffMaps(p, aMap, bMap, ops)
+			} else if !valuesEqual(aVal, bVal) {
+				*ops = append(*ops, map[string]interface{}{"op": "replace", "path": p, "value": bVal})
+			}
+		}
+	}
+}
+
+// valuesEqual reports whether 2 values are equal.
+// js objects are compared by identity.
+func valuesEqual(a, b interface{}) bool {
+	aObj, aIsObj := a.(*js.Object)
+	bObj, bIsObj := b.(*js.Object)
+	if aIsObj || bIsObj {
+		return aIsObj && bIsObj && aObj == bObj
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,8 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !native
+// +build !native
+
+package react
+
+const nativeBuild = false
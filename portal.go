@@ -0,0 +1,77 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// CreatePortal renders child into a DOM node (container) that exists outside
+// the DOM hierarchy of the parent component.
+//
+// See: https://reactjs.org/docs/portals.html
+func CreatePortal(child interface{}, container *js.Object, key ...string) *js.Object {
+	if len(key) > 0 {
+		return ReactDOM.Call("createPortal", child, container, key[0])
+	}
+	return ReactDOM.Call("createPortal", child, container)
+}
+
+// PortalHost renders a container element that Portal elements with the
+// same id render their children into.
+//
+// Example:
+//
+//  // Near the root of the app
+//  react.PortalHost("modals")
+//
+//  // Deep inside the tree
+//  react.Portal("modals", modal)
+//
+func PortalHost(id string) interface{} {
+	return JSX("div", map[string]interface{}{"id": id})
+}
+
+// Portal renders children into the element with the id hostID (usually
+// rendered by PortalHost) regardless of where Portal is in the tree.
+//
+// Each Portal renders into its own wrapper inside the host, so multiple
+// portals with the same hostID append their children in render order
+// and unmounting a Portal removes only its own children.
+// Nothing is rendered if the host does not exist.
+func Portal(hostID string, children ...interface{}) interface{} {
+	props := map[string]interface{}{
+		"hostID":   hostID,
+		"children": children,
+	}
+	return JSX(portalComponent, props)
+}
+
+// portalComponent is the functional component used by Portal.
+var portalComponent = NamedComponent("Portal", func(this *js.Object, arguments []*js.Object) interface{} {
+	props := arguments[0]
+	hostID := props.Get("hostID").String()
+
+	container, setContainer := useState(nil)
+
+	useEffect(func() func() {
+		host := GetElementByID(hostID)
+		if host == nil {
+			return nil
+		}
+
+		wrapper := js.Global.Get("document").Call("createElement", "div")
+		wrapper.Get("style").Set("display", "contents")
+		host.Call("appendChild", wrapper)
+		setContainer(wrapper)
+
+		return func() {
+			host.Call("removeChild", wrapper)
+		}
+	}, []interface{}{hostID})
+
+	if container == nil {
+		return nil
+	}
+	return CreatePortal(props.Get("children"), container)
+})
@@ -0,0 +1,193 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !native
+// +build !native
+
+package react
+
+import (
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// PrintSettleDelay is how long Print waits after entering print mode before
+// opening the print dialog, so that components (and their effects) can render
+// their expanded content.
+var PrintSettleDelay = 100 * time.Millisecond
+
+// printRootID is the id of the container that PrintSection renders into.
+const printRootID = "react-print-root"
+
+var (
+	printMode      bool
+	printRequested bool // print mode was entered by Print
+
+	// printSubscribers are notified when print mode changes.
+	printSubscribers = map[int]func(){}
+	nextPrintSubID   int
+	printListening   bool
+
+	// printSections is the number of mounted PrintSections.
+	printSections int
+)
+
+// setPrintMode changes print mode and re-renders the components that use UsePrintMode.
+func setPrintMode(on bool) {
+	if printMode == on {
+		return
+	}
+	printMode = on
+
+	BatchedUpdates(func() {
+		for _, fn := range printSubscribers {
+			fn()
+		}
+	})
+}
+
+// listenForPrint adds the listeners that detect when the page is printed.
+func listenForPrint() {
+	if printListening || js.Global.Get("addEventListener") == js.Undefined {
+		return
+	}
+	printListening = true
+
+	js.Global.Call("addEventListener", "beforeprint", func(e *js.Object) {
+		setPrintMode(true)
+	})
+	js.Global.Call("addEventListener", "afterprint", func(e *js.Object) {
+		printRequested = false
+		setPrintMode(false)
+	})
+
+	// Some browsers only report printing through the "print" media query
+	if mql := mediaQueryList("print"); mql != nil {
+		mql.Call("addListener", func(e *js.Object) {
+			if e.Get("matches").Bool() {
+				setPrintMode(true)
+			} else if !printRequested {
+				setPrintMode(false)
+			}
+		})
+	}
+}
+
+// UsePrintMode is a hook that returns true while the page is being printed
+// (or Print is preparing to print). The component re-renders when it changes,
+// so that it can render expanded content for printing (eg. all the rows of a
+// virtualized list, collapsed sections and no interactive controls).
+//
+// Example:
+//
+//  printing := react.UsePrintMode()
+//  if printing {
+//      return renderAllRows(rows)
+//  }
+//  return renderVisibleRows(rows)
+//
+func UsePrintMode() bool {
+
+	listenForPrint()
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		version := 0
+		nextPrintSubID++
+		id := nextPrintSubID
+		printSubscribers[id] = func() {
+			version++
+			setVersion(version)
+		}
+		return func() {
+			delete(printSubscribers, id)
+		}
+	}, []interface{}{})
+
+	return printMode
+}
+
+// Print enters print mode, waits for PrintSettleDelay (so that components using
+// UsePrintMode can finish rendering) and then opens the browser's print dialog.
+// Print mode ends when the dialog is closed.
+func Print() {
+	listenForPrint()
+
+	printRequested = true
+	setPrintMode(true)
+
+	js.Global.Call("setTimeout", func() {
+		js.Global.Call("print")
+	}, PrintSettleDelay.Milliseconds())
+}
+
+// printRoot returns the container that PrintSection renders into. It is created
+// (along with the stylesheet that hides it on screen) the first time it is needed.
+func printRoot() *js.Object {
+	if root := GetElementByID(printRootID); root != nil {
+		return root
+	}
+
+	document := js.Global.Get("document")
+
+	style := document.Call("createElement", "style")
+	style.Set("textContent", "@media screen { #"+printRootID+" { display: none !important; } }\n"+
+		"@media print { body.react-print-sections > :not(#"+printRootID+") { display: none !important; } }")
+	document.Get("head").Call("appendChild", style)
+
+	root := document.Call("createElement", "div")
+	root.Set("id", printRootID)
+	document.Get("body").Call("appendChild", root)
+	return root
+}
+
+// PrintSection renders children into a print-only container. The children are
+// hidden on screen and, while at least one PrintSection is mounted, they are the
+// only content of the page that is printed. It is used to provide a printable
+// version of a report that differs from the interactive version.
+//
+// Example:
+//
+//  react.PrintSection(
+//      react.JSX(ReportTable, &ReportProps{Rows: rows}),
+//  )
+//
+func PrintSection(children ...interface{}) interface{} {
+	props := map[string]interface{}{
+		"children": children,
+	}
+	return JSX(printSectionComponent, props)
+}
+
+// printSectionComponent is the functional component used by PrintSection.
+var printSectionComponent = NamedComponent("PrintSection", func(this *js.Object, arguments []*js.Object) interface{} {
+	props := arguments[0]
+
+	container, setContainer := useState(nil)
+
+	useEffect(func() func() {
+		root := printRoot()
+
+		wrapper := js.Global.Get("document").Call("createElement", "div")
+		root.Call("appendChild", wrapper)
+		setContainer(wrapper)
+
+		printSections++
+		js.Global.Get("document").Get("body").Get("classList").Call("add", "react-print-sections")
+
+		return func() {
+			root.Call("removeChild", wrapper)
+
+			printSections--
+			if printSections == 0 {
+				js.Global.Get("document").Get("body").Get("classList").Call("remove", "react-print-sections")
+			}
+		}
+	}, []interface{}{})
+
+	if container == nil {
+		return nil
+	}
+	return CreatePortal(props.Get("children"), container)
+})
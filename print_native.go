@@ -0,0 +1,26 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build native
+// +build native
+
+package react
+
+import (
+	"time"
+)
+
+// PrintSettleDelay is not used in React Native.
+var PrintSettleDelay = 100 * time.Millisecond
+
+// UsePrintMode always returns false in React Native.
+func UsePrintMode() bool {
+	return false
+}
+
+// Print does nothing in React Native.
+func Print() {}
+
+// PrintSection renders nothing in React Native.
+func PrintSection(children ...interface{}) interface{} {
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react/forks/mapstructure"
+)
+
+// Await blocks until promise is settled. If the promise is fulfilled,
+// the resolved value is returned. If it is rejected, then a *js.Error
+// containing the reason is returned.
+//
+// NOTE: Await must be called from a goroutine. It can't be called directly
+// inside a function invoked by javascript (such as an event handler or render).
+//
+// Example:
+//
+//  go func() {
+//      res, err := react.Await(promise)
+//  }()
+//
+func Await(promise *js.Object) (*js.Object, error) {
+
+	type result struct {
+		val *js.Object
+		err error
+	}
+
+	ch := make(chan result, 1)
+
+	promise.Call("then", func(val *js.Object) {
+		ch <- result{val: val}
+	}, func(reason *js.Object) {
+		ch <- result{err: &js.Error{Object: reason}}
+	})
+
+	res := <-ch
+	return res.val, res.err
+}
+
+// JSFnPromise is the same as JSFn except that it is used for native functions that
+// return a promise. It waits for the promise to settle and returns the result.
+//
+// NOTE: Just like Await, it must be called from a goroutine.
+//
+// Example:
+//
+//  go func() {
+//      // await fetch('/api')
+//      resp, err := react.JSFnPromise("fetch", "/api")
+//  }()
+//
+func JSFnPromise(funcName string, args ...interface{}) (*js.Object, error) {
+	promise, err := JSFn(funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+	return Await(promise)
+}
+
+var (
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	jsObjectType = reflect.TypeOf((*js.Object)(nil))
+)
+
+// Promisify converts fn into a javascript function that returns a native Promise.
+// It is used for props that expect "a function returning a Promise" (eg. async validators
+// and loaders). fn must be a func whose last return value can be an error:
+// func(args...), func(args...) error, func(args...) T or func(args...) (T, error).
+//
+// When the javascript function is called, fn runs in a goroutine (so it can block).
+// The Promise is rejected if fn returns a non-nil error (or panics), otherwise it is
+// resolved with the result. Structs are converted using SToMap.
+// The javascript arguments are decoded into the types of fn's parameters using the
+// "react" struct tag (*js.Object parameters receive the argument as is).
+//
+// Struct fields with the "promise" tag option are converted automatically.
+//
+// Example:
+//
+//  loadOptions := react.Promisify(func(input string) ([]Option, error) {
+//      return searchOptions(input)
+//  })
+//
+func Promisify(fn interface{}) *js.Object {
+
+	fv := reflect.ValueOf(fn)
+	ft := fv.Type()
+
+	if ft.Kind() != reflect.Func || ft.IsVariadic() {
+		panic("fn must be a non-variadic func")
+	}
+
+	returnsErr := ft.NumOut() > 0 && ft.Out(ft.NumOut()-1) == errorType
+	numResults := ft.NumOut()
+	if returnsErr {
+		numResults--
+	}
+	if numResults > 1 {
+		panic("fn must return at most one value and an error")
+	}
+
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		return js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+			go func() {
+				defer func() {
+					if r := recover(); r != nil {
+						reject.Invoke(jsError(panicError(r)))
+					}
+				}()
+
+				in := make([]reflect.Value, ft.NumIn())
+				for i := range in {
+					var arg *js.Object
+					if i < len(arguments) {
+						arg = arguments[i]
+					}
+
+					v, err := jsArgValue(arg, ft.In(i))
+					if err != nil {
+						reject.Invoke(jsError(err))
+						return
+					}
+					in[i] = v
+				}
+
+				out := fv.Call(in)
+
+				if returnsErr {
+					if err := out[len(out)-1].Interface(); err != nil {
+						reject.Invoke(jsError(err.(error)))
+						return
+					}
+				}
+
+				if numResults == 0 {
+					resolve.Invoke()
+					return
+				}
+
+				res := out[0].Interface()
+				if isStruct(res) && !jsObjectIsNotNil(res) {
+					res = SToMap(res)
+				}
+				resolve.Invoke(res)
+			}()
+		})
+	})
+}
+
+// jsArgValue decodes a javascript argument into type t.
+func jsArgValue(arg *js.Object, t reflect.Type) (reflect.Value, error) {
+
+	if t == jsObjectType {
+		return reflect.ValueOf(arg), nil
+	}
+
+	v := reflect.New(t)
+	if arg == nil || arg == js.Undefined {
+		return v.Elem(), nil
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:  "react",
+		FieldKey: reactFieldKey,
+		Result:   v.Interface(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := decoder.Decode(arg.Interface()); err != nil {
+		return v.Elem(), err
+	}
+	return v.Elem(), nil
+}
+
+// jsError converts err into a javascript Error. *js.Error values are unwrapped.
+func jsError(err error) *js.Object {
+	if jsErr, ok := err.(*js.Error); ok {
+		return jsErr.Object
+	}
+	return js.Global.Get("Error").New(err.Error())
+}
+
+// panicError converts a recovered value into an error.
+func panicError(r interface{}) error {
+	switch x := r.(type) {
+	case error:
+		return x
+	case string:
+		return errors.New(x)
+	}
+	return errors.New("panic in promisified function")
+}
@@ -0,0 +1,95 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+type settled struct {
+	resolved bool
+	value    *js.Object
+}
+
+// stubPromise replaces the global Promise constructor with one that
+// reports how the promise was settled.
+func stubPromise(t *testing.T) <-chan settled {
+	ch := make(chan settled, 1)
+
+	original := js.Global.Get("Promise")
+	t.Cleanup(func() {
+		js.Global.Set("Promise", original)
+	})
+
+	stub := js.Global.Get("Function").New("record", `
+		return function(executor) {
+			executor(function(v) { record(true, v) }, function(r) { record(false, r) })
+		}
+	`).Invoke(func(resolved bool, value *js.Object) {
+		ch <- settled{resolved, value}
+	})
+	js.Global.Set("Promise", stub)
+
+	return ch
+}
+
+func TestPromisifyResolve(t *testing.T) {
+	ch := stubPromise(t)
+
+	type result struct {
+		Sum int `react:"sum"`
+	}
+
+	fn := Promisify(func(a, b int) (result, error) {
+		return result{Sum: a + b}, nil
+	})
+	fn.Invoke(2, 3)
+
+	res := <-ch
+	if !res.resolved {
+		t.Fatalf("expected promise to be resolved, got rejected with: %v", res.value)
+	}
+	if sum := res.value.Get("sum").Int(); sum != 5 {
+		t.Errorf("expected sum 5, got %d", sum)
+	}
+}
+
+func TestPromisifyReject(t *testing.T) {
+	ch := stubPromise(t)
+
+	fn := Promisify(func(name string) (string, error) {
+		return "", errors.New("invalid: " + name)
+	})
+	fn.Invoke("bob")
+
+	res := <-ch
+	if res.resolved {
+		t.Fatalf("expected promise to be rejected")
+	}
+	if msg := res.value.Get("message").String(); msg != "invalid: bob" {
+		t.Errorf("unexpected message: %q", msg)
+	}
+}
+
+func TestPromiseTagOption(t *testing.T) {
+	ch := stubPromise(t)
+
+	type props struct {
+		Validate func(string) error `react:"validate,promise"`
+	}
+
+	mp := SToMap(props{Validate: func(s string) error { return nil }})
+	mp["validate"].(*js.Object).Invoke("ok")
+
+	if res := <-ch; !res.resolved {
+		t.Fatalf("expected promise to be resolved")
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// QueryStateOptions configures UseQueryState and UseQueryStruct.
+type QueryStateOptions struct {
+	// Push adds a new history entry (using pushState) instead of
+	// replacing the current entry (using replaceState).
+	Push bool
+}
+
+var (
+	// queryUpdates are the parameters that will be written to the url. A nil value deletes the parameter.
+	queryUpdates = map[string][]string{}
+	queryPush    bool
+	queryFlush   bool // a flush has been scheduled
+
+	// querySubscribers are notified when the url's parameters change.
+	querySubscribers = map[int]func(){}
+	nextQuerySubID   int
+	queryListening   bool
+)
+
+// queryParams returns the values of the url's parameter. exists is false if the parameter is not present.
+func queryParams(name string) (vals []string, exists bool) {
+	if pending, exists := queryUpdates[name]; exists {
+		return pending, pending != nil
+	}
+
+	params := js.Global.Get("URLSearchParams").New(js.Global.Get("location").Get("search"))
+	if !params.Call("has", name).Bool() {
+		return nil, false
+	}
+
+	all := params.Call("getAll", name)
+	for i := 0; i < all.Length(); i++ {
+		vals = append(vals, all.Index(i).String())
+	}
+	return vals, true
+}
+
+// setQueryParams schedules the url to be updated. Multiple calls in the same
+// tick are written as a single history entry.
+func setQueryParams(name string, vals []string, push bool) {
+	queryUpdates[name] = vals
+	queryPush = queryPush || push
+
+	if queryFlush {
+		return
+	}
+	queryFlush = true
+
+	js.Global.Call("setTimeout", func() {
+		params := js.Global.Get("URLSearchParams").New(js.Global.Get("location").Get("search"))
+		for name, vals := range queryUpdates {
+			params.Call("delete", name)
+			for _, val := range vals {
+				params.Call("append", name, val)
+			}
+		}
+
+		url := js.Global.Get("location").Get("pathname").String()
+		if search := params.Call("toString").String(); search != "" {
+			url = url + "?" + search
+		}
+		url = url + js.Global.Get("location").Get("hash").String()
+
+		if queryPush {
+			js.Global.Get("history").Call("pushState", js.Global.Get("history").Get("state"), "", url)
+		} else {
+			js.Global.Get("history").Call("replaceState", js.Global.Get("history").Get("state"), "", url)
+		}
+
+		queryUpdates = map[string][]string{}
+		queryPush = false
+		queryFlush = false
+
+		notifyQuerySubscribers()
+	}, 0)
+}
+
+func notifyQuerySubscribers() {
+	for _, fn := range querySubscribers {
+		fn()
+	}
+}
+
+// useQuerySubscription re-renders the component when the url's parameters change.
+func useQuerySubscription() {
+
+	if !queryListening {
+		queryListening = true
+		js.Global.Call("addEventListener", "popstate", func(e *js.Object) {
+			notifyQuerySubscribers()
+		})
+	}
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		version := 0
+		nextQuerySubID++
+		id := nextQuerySubID
+		querySubscribers[id] = func() {
+			version++
+			setVersion(version)
+		}
+		return func() {
+			delete(querySubscribers, id)
+		}
+	}, []interface{}{})
+}
+
+// encodeQueryValue converts v into the values of a url parameter.
+// Slices become multiple values.
+func encodeQueryValue(v reflect.Value) []string {
+
+	v, isNil := indirect(v)
+	if isNil {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return []string{v.String()}
+	case reflect.Bool:
+		return []string{strconv.FormatBool(v.Bool())}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{strconv.FormatInt(v.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []string{strconv.FormatUint(v.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return []string{strconv.FormatFloat(v.Float(), 'g', -1, 64)}
+	case reflect.Slice, reflect.Array:
+		out := []string{}
+		for i := 0; i < v.Len(); i++ {
+			out = append(out, encodeQueryValue(v.Index(i))...)
+		}
+		return out
+	}
+
+	panic("unsupported query parameter type: " + v.Type().String())
+}
+
+// decodeQueryValue converts the values of a url parameter into type t.
+// ok is false if the values can't be converted.
+func decodeQueryValue(vals []string, t reflect.Type) (_ reflect.Value, ok bool) {
+
+	out := reflect.New(t).Elem()
+
+	if t.Kind() == reflect.Ptr {
+		elem, ok := decodeQueryValue(vals, t.Elem())
+		if !ok {
+			return out, false
+		}
+		out.Set(reflect.New(t.Elem()))
+		out.Elem().Set(elem)
+		return out, true
+	}
+
+	if t.Kind() == reflect.Slice {
+		slc := reflect.MakeSlice(t, 0, len(vals))
+		for _, val := range vals {
+			elem, ok := decodeQueryValue([]string{val}, t.Elem())
+			if !ok {
+				return out, false
+			}
+			slc = reflect.Append(slc, elem)
+		}
+		out.Set(slc)
+		return out, true
+	}
+
+	if len(vals) == 0 {
+		return out, false
+	}
+	val := vals[0]
+
+	switch t.Kind() {
+	case reflect.String:
+		out.SetString(val)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return out, false
+		}
+		out.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(val, 10, t.Bits())
+		if err != nil {
+			return out, false
+		}
+		out.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(val, 10, t.Bits())
+		if err != nil {
+			return out, false
+		}
+		out.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(val, t.Bits())
+		if err != nil {
+			return out, false
+		}
+		out.SetFloat(f)
+	default:
+		return out, false
+	}
+
+	return out, true
+}
+
+// UseQueryState is a hook that stores a value in the url's query parameter called name,
+// so that the state can be shared using links (eg. filters, sorting and pagination).
+// The type of def determines how the parameter is parsed. Strings, bools, numbers and
+// slices of them (as repeated parameters) are supported. def is returned if the parameter
+// is missing or invalid, and setting def removes the parameter.
+//
+// Setting the value updates the url using history.replaceState (or pushState if Push is set).
+// Multiple updates in the same tick (even from different hooks) are written as a single history
+// entry. The component re-renders when the url changes (including the browser's back button).
+//
+// Example:
+//
+//  page, setPage := react.UseQueryState("page", 1)
+//  setPage(page.(int) + 1)
+//
+func UseQueryState(name string, def interface{}, opts ...QueryStateOptions) (value interface{}, set func(interface{})) {
+
+	useQuerySubscription()
+
+	var o QueryStateOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	value = def
+	if vals, exists := queryParams(name); exists && def != nil {
+		if v, ok := decodeQueryValue(vals, reflect.TypeOf(def)); ok {
+			value = v.Interface()
+		}
+	}
+
+	return value, func(v interface{}) {
+		if v == nil || reflect.DeepEqual(v, def) {
+			setQueryParams(name, nil, o.Push)
+			return
+		}
+		setQueryParams(name, encodeQueryValue(reflect.ValueOf(v)), o.Push)
+	}
+}
+
+// UseQueryStruct is the same as UseQueryState except that it syncs multiple fields of a struct.
+// dest must be a pointer to a struct. The "react" struct tag names the parameters. Fields whose
+// parameters are present in the url are populated. The values already in dest act as defaults.
+// set writes every field of its argument (a struct of the same type) to the url. Zero values
+// (and values equal to the defaults) are removed from the url.
+//
+// Example:
+//
+//  type Filters struct {
+//      Search string   `react:"q"`
+//      Tags   []string `react:"tag"`
+//      Page   int      `react:"page"`
+//  }
+//
+//  filters := Filters{Page: 1}
+//  setFilters := react.UseQueryStruct(&filters)
+//
+func UseQueryStruct(dest interface{}, opts ...QueryStateOptions) (set func(interface{})) {
+
+	useQuerySubscription()
+
+	var o QueryStateOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	s := reflect.ValueOf(dest).Elem()
+	defaults := reflect.New(s.Type()).Elem()
+	defaults.Set(s)
+
+	fields := cachedFields(s.Type(), defaultOptions)
+
+	for _, fi := range fields {
+		if fi.skip || fi.embedded {
+			continue
+		}
+		if vals, exists := queryParams(fi.key); exists {
+			if v, ok := decodeQueryValue(vals, s.Field(fi.index).Type()); ok {
+				s.Field(fi.index).Set(v)
+			}
+		}
+	}
+
+	return func(v interface{}) {
+		rv := reflect.Indirect(reflect.ValueOf(v))
+		for _, fi := range fields {
+			if fi.skip || fi.embedded {
+				continue
+			}
+
+			field := rv.Field(fi.index)
+			if field.IsZero() || reflect.DeepEqual(field.Interface(), defaults.Field(fi.index).Interface()) {
+				setQueryParams(fi.key, nil, o.Push)
+			} else {
+				setQueryParams(fi.key, encodeQueryValue(field), o.Push)
+			}
+		}
+	}
+}
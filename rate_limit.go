@@ -0,0 +1,208 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// RateLimitOptions configures UseThrottled and UseDebounced.
+type RateLimitOptions struct {
+	// FlushOnUnmount calls the pending call (if any) when the component unmounts.
+	// By default, it is cancelled.
+	FlushOnUnmount bool
+}
+
+// rateLimiter records the state of a throttled or debounced handler.
+type rateLimiter struct {
+	handler  reflect.Value
+	interval time.Duration
+	debounce bool
+
+	last    time.Time
+	timer   *time.Timer
+	pending []reflect.Value // arguments of the pending call
+}
+
+func newRateLimiter(handler interface{}, ms int, debounce bool) *rateLimiter {
+	fn := reflect.ValueOf(handler)
+	if fn.Kind() != reflect.Func {
+		panic("handler must be a func")
+	}
+	return &rateLimiter{handler: fn, interval: time.Duration(ms) * time.Millisecond, debounce: debounce}
+}
+
+// wrap returns a func with the same type as the handler.
+func (r *rateLimiter) wrap() interface{} {
+	t := r.handler.Type()
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		r.call(args)
+
+		results := make([]reflect.Value, t.NumOut())
+		for i := range results {
+			results[i] = reflect.Zero(t.Out(i))
+		}
+		return results
+	}).Interface()
+}
+
+func (r *rateLimiter) call(args []reflect.Value) {
+
+	if !r.debounce && r.timer == nil && time.Since(r.last) >= r.interval {
+		// Leading edge
+		r.last = time.Now()
+		r.handler.Call(args)
+		return
+	}
+
+	// The event is used after the handler returns
+	persistEvents(args)
+	r.pending = args
+
+	delay := r.interval
+	if r.debounce {
+		if r.timer != nil {
+			r.timer.Stop()
+		}
+	} else {
+		if r.timer != nil {
+			// The trailing call is already scheduled
+			return
+		}
+		delay = r.interval - time.Since(r.last)
+	}
+
+	r.timer = time.AfterFunc(delay, r.flush)
+}
+
+// flush makes the pending call immediately.
+func (r *rateLimiter) flush() {
+	r.cancel()
+	if r.pending == nil {
+		return
+	}
+
+	args := r.pending
+	r.pending = nil
+	r.last = time.Now()
+	r.handler.Call(args)
+}
+
+// cancel stops the timer. The pending call is not discarded.
+func (r *rateLimiter) cancel() {
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+}
+
+// persistEvents removes React's pooled events from the pool (React 16 and earlier),
+// so they can be read later.
+func persistEvents(args []reflect.Value) {
+	for _, arg := range args {
+		switch e := arg.Interface().(type) {
+		case *SyntheticEvent:
+			if e != nil && e.O != nil {
+				e.Persist()
+			}
+		case *js.Object:
+			if e != nil && e != js.Undefined && e.Get("persist") != js.Undefined {
+				e.Call("persist")
+			}
+		}
+	}
+}
+
+// Throttled returns a version of handler that is called at most once every ms milliseconds.
+// The first call is made immediately. Calls made while throttled are dropped, except for the
+// latest one which is made at the end of the interval. handler must be a func (eg. func(*js.Object)
+// or func(*SyntheticEvent)), and the returned value has the same type.
+//
+// It is used for high-frequency events such as onMouseMove and onScroll.
+//
+// Example:
+//
+//  onScroll := react.Throttled(func(e *js.Object) {
+//      setScrollTop(e.Get("target").Get("scrollTop").Int())
+//  }, 100)
+//
+// See: UseThrottled
+func Throttled(handler interface{}, ms int) interface{} {
+	return newRateLimiter(handler, ms, false).wrap()
+}
+
+// Debounced returns a version of handler that is only called once ms milliseconds
+// have passed without it being called again. The latest arguments are used.
+// handler must be a func, and the returned value has the same type.
+//
+// Example:
+//
+//  onChange := react.Debounced(func(e *react.SyntheticEvent) {
+//      search(e.TargetValue())
+//  }, 300)
+//
+// See: UseDebounced
+func Debounced(handler interface{}, ms int) interface{} {
+	return newRateLimiter(handler, ms, true).wrap()
+}
+
+// useRateLimited is the implementation of UseThrottled and UseDebounced.
+func useRateLimited(handler interface{}, ms int, debounce bool, opts []RateLimitOptions) interface{} {
+
+	type rateLimitedState struct {
+		limiter *rateLimiter
+		wrapped interface{}
+	}
+
+	st := useGoRef(func() interface{} {
+		r := newRateLimiter(handler, ms, debounce)
+		return &rateLimitedState{limiter: r, wrapped: r.wrap()}
+	}).(*rateLimitedState)
+
+	// The latest handler is always called
+	st.limiter.handler = reflect.ValueOf(handler)
+	st.limiter.interval = time.Duration(ms) * time.Millisecond
+
+	var o RateLimitOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	useEffect(func() func() {
+		return func() {
+			// Unmounting
+			if o.FlushOnUnmount {
+				st.limiter.flush()
+			} else {
+				st.limiter.cancel()
+				st.limiter.pending = nil
+			}
+		}
+	}, []interface{}{})
+
+	return st.wrapped
+}
+
+// UseThrottled is a hook that is the same as Throttled except that the returned func
+// doesn't change between renders and always calls the latest handler. When the component
+// unmounts, the pending call is cancelled (or made if FlushOnUnmount is set).
+func UseThrottled(handler interface{}, ms int, opts ...RateLimitOptions) interface{} {
+	return useRateLimited(handler, ms, false, opts)
+}
+
+// UseDebounced is a hook that is the same as Debounced except that the returned func
+// doesn't change between renders and always calls the latest handler. When the component
+// unmounts, the pending call is cancelled (or made if FlushOnUnmount is set).
+//
+// Example:
+//
+//  onChange := react.UseDebounced(func(e *react.SyntheticEvent) {
+//      save(e.TargetValue())
+//  }, 500, react.RateLimitOptions{FlushOnUnmount: true})
+//
+func UseDebounced(handler interface{}, ms int, opts ...RateLimitOptions) interface{} {
+	return useRateLimited(handler, ms, true, opts)
+}
@@ -33,8 +33,38 @@ var (
 	//
 	// See: https://www.npmjs.com/package/react-addons-pure-render-mixin
 	PureComponentMixin = js.Global.Get("PureRenderMixin")
+
+	// Development enables additional checks and warnings that are
+	// printed to the console. It defaults to true when process.env.NODE_ENV
+	// is set to anything other than "production".
+	Development = isDevelopment()
 )
 
+// isDevelopment returns true if process.env.NODE_ENV is
+// set and is not "production".
+func isDevelopment() bool {
+	process := js.Global.Get("process")
+	if process == nil || process == js.Undefined || process.Get("env") == js.Undefined {
+		return false
+	}
+
+	env := process.Get("env").Get("NODE_ENV")
+	return env != js.Undefined && env.String() != "production"
+}
+
+// warned records the messages printed by warnOnce.
+var warned = map[string]bool{}
+
+// warnOnce prints a warning to the console if Development is true.
+// Each message is only printed once.
+func warnOnce(msg string) {
+	if !Development || warned[msg] {
+		return
+	}
+	warned[msg] = true
+	js.Global.Get("console").Call("warn", msg)
+}
+
 // GetElementByID will return the first element with the specified id in the dom object.
 // If no dom is provided, window.document will be used.
 func GetElementByID(id string, dom ...*js.Object) *js.Object {
@@ -45,9 +75,77 @@ func GetElementByID(id string, dom ...*js.Object) *js.Object {
 }
 
 // Render will render component to the specified target dom element.
+//
+// When ReactDOM.createRoot is not available (React 17 and earlier), the legacy
+// ReactDOM.render API is used and the component instance is returned. Otherwise
+// (React 18), nil is returned (use a ref to access the instance) and, since roots
+// don't accept a callback, callback is called using setTimeout. It may therefore
+// be called before React has committed the render.
+//
+// Deprecated: Use CreateRoot and Root.Render instead.
 func Render(element *js.Object, domTarget *js.Object, callback ...func()) *js.Object {
-	if len(callback) > 0 && callback[0] != nil {
-		return ReactDOM.Call("render", element, domTarget, callback[0])
+	var cb func()
+	if len(callback) > 0 {
+		cb = callback[0]
+	}
+	return CreateRoot(domTarget).render(element, cb)
+}
+
+// Hydrate is the same as Render except that it attaches event listeners to markup
+// that was rendered on the server.
+//
+// When ReactDOM.hydrateRoot is not available (React 17 and earlier), the legacy
+// ReactDOM.hydrate API is used and the component instance is returned. Otherwise
+// (React 18), the root's internal object is returned and callback is called using
+// setTimeout. It may therefore be called before React has committed the hydration.
+//
+// Deprecated: Use HydrateRoot instead.
+//
+// See: https://reactjs.org/docs/react-dom.html#hydrate
+func Hydrate(element *js.Object, domTarget *js.Object, callback ...func()) *js.Object {
+	if existing := domTarget.Get(rootKey); existing != js.Undefined && existing != nil {
+		// Already hydrated
+		return Render(element, domTarget, callback...)
+	}
+
+	var cb func()
+	if len(callback) > 0 {
+		cb = callback[0]
+	}
+
+	r, instance := hydrateRoot(domTarget, element, cb)
+	if r.O == nil {
+		// Legacy API
+		return instance
+	}
+
+	if cb != nil {
+		// React 18 roots don't accept a callback
+		js.Global.Call("setTimeout", cb, 0)
+	}
+	return r.O
+}
+
+// BatchedUpdates calls fn and batches the state updates made inside it into a single re-render.
+// React batches updates inside event handlers, but in React 17 (and earlier) updates made
+// elsewhere (eg. in a setTimeout callback or after a fetch response) re-render once per update.
+// If ReactDOM.unstable_batchedUpdates is not available, fn is called directly.
+//
+// Example:
+//
+//  go func() {
+//      res, err := react.JSFnPromise("fetch", "/api")
+//      react.BatchedUpdates(func() {
+//          setLoading(false)
+//          setResponse(res)
+//      })
+//  }()
+//
+// NOTE: React 18's createRoot batches all updates automatically.
+func BatchedUpdates(fn func()) {
+	if ReactDOM == nil || ReactDOM == js.Undefined || ReactDOM.Get("unstable_batchedUpdates") == js.Undefined {
+		fn()
+		return
 	}
-	return ReactDOM.Call("render", element, domTarget)
+	ReactDOM.Call("unstable_batchedUpdates", fn)
 }
@@ -3,6 +3,8 @@
 package react
 
 import (
+	"reflect"
+
 	"github.com/gopherjs/gopherjs/js"
 )
 
@@ -33,6 +35,160 @@ func ForceUpdate(this *js.Object, callback ...func()) {
 	}
 }
 
+// ForceUpdateWith updates the state of a class component and re-renders it.
+// state can be a struct, js.M, map or an updater function of the form
+// func(prevState StateStruct) StateStruct.
+//
+// The updater form is the only safe way to update state that depends on the
+// previous state (eg. incrementing a counter), since state updates are batched.
+// The previous state is unmarshaled into StateStruct, and the returned struct
+// is converted using SToMap. StateStruct can also be a pointer to a struct.
+//
+// If state (or the updater's result) is nil or converts to an empty map, nothing is updated.
+// If the updater is not of the correct form or the previous state can't be unmarshaled
+// into StateStruct, a *ConversionError is panicked.
+//
+// See TypedSetState for a type-safe version of the updater form.
+//
+// Example:
+//
+//  react.ForceUpdateWith(this, func(prev CounterState) CounterState {
+//      prev.Count++
+//      return prev
+//  })
+//
+// See: https://reactjs.org/docs/react-component.html#setstate
+func ForceUpdateWith(this *js.Object, state interface{}, callback ...func()) {
+
+	if state == nil {
+		return
+	}
+
+	var updater interface{}
+
+	if fn := reflect.ValueOf(state); fn.Kind() == reflect.Func {
+		t := fn.Type()
+		if t.NumIn() != 1 || t.NumOut() != 1 {
+			panic(&ConversionError{Kind: reflect.Func, Msg: "updater must have the form func(prevState StateStruct) StateStruct"})
+		}
+
+		updater = func(prevState, props *js.Object) interface{} {
+			prev := reflect.New(t.In(0))
+
+			target := prev.Interface()
+			if t.In(0).Kind() == reflect.Ptr {
+				// Allocate the struct that the pointer points to
+				prev.Elem().Set(reflect.New(t.In(0).Elem()))
+				target = prev.Elem().Interface()
+			}
+
+			if mp, ok := prevState.Interface().(map[string]interface{}); ok {
+				if err := UnmarshalStruct(mp, target); err != nil {
+					if cErr, ok := err.(*ConversionError); ok {
+						panic(cErr)
+					}
+					panic(&ConversionError{Kind: t.In(0).Kind(), Msg: "prevState: " + err.Error()})
+				}
+			}
+
+			next := fn.Call([]reflect.Value{prev.Elem()})[0].Interface()
+
+			mp := SToMap(next)
+			if len(mp) == 0 {
+				// Returning null skips the update
+				return nil
+			}
+			return mp
+		}
+	} else {
+		mp := SToMap(state)
+		if len(mp) == 0 {
+			return
+		}
+		updater = mp
+	}
+
+	if len(callback) > 0 && callback[0] != nil {
+		this.Call("setState", updater, callback[0])
+	} else {
+		this.Call("setState", updater)
+	}
+}
+
+// TypedSetState updates the state of a class component using updater and re-renders it.
+// It is the type-safe form of ForceUpdateWith's updater function (the name SetState is
+// already used by the setState function type). The previous state is unmarshaled into S
+// and the returned value is converted using SToMap.
+//
+// Example:
+//
+//  react.TypedSetState(this, func(prev CounterState) CounterState {
+//      prev.Count++
+//      return prev
+//  })
+//
+// See: https://reactjs.org/docs/react-component.html#setstate
+func TypedSetState[S any](this *js.Object, updater func(prevState S) S, callback ...func()) {
+	if updater == nil {
+		return
+	}
+	ForceUpdateWith(this, updater, callback...)
+}
+
+// SetStateDiff converts prev and next using SToMap and calls setState with only the
+// keys that have changed. Values are compared using reflect.DeepEqual, except for
+// *js.Object values which are compared by reference. Keys that are missing from next
+// are set to undefined. If nothing has changed, setState is not called but callback still is.
+//
+// Example:
+//
+//  var state AppState
+//  react.UnmarshalState(this, &state)
+//
+//  next := state
+//  next.Count++
+//  react.SetStateDiff(this, state, next)
+//
+// See: https://reactjs.org/docs/react-component.html#setstate
+func SetStateDiff(this *js.Object, prev, next interface{}, callback ...func()) {
+
+	diff := stateDiff(SToMap(prev), SToMap(next))
+
+	if len(diff) == 0 {
+		if len(callback) > 0 && callback[0] != nil {
+			callback[0]()
+		}
+		return
+	}
+
+	if len(callback) > 0 && callback[0] != nil {
+		this.Call("setState", diff, callback[0])
+	} else {
+		this.Call("setState", diff)
+	}
+}
+
+// stateDiff returns the keys of next that are different from prev.
+// Keys that are only in prev are set to undefined.
+func stateDiff(prev, next map[string]interface{}) map[string]interface{} {
+
+	diff := map[string]interface{}{}
+
+	for k, v := range next {
+		if prevV, exists := prev[k]; !exists || !valuesEqual(prevV, v) {
+			diff[k] = v
+		}
+	}
+
+	for k := range prev {
+		if _, exists := next[k]; !exists {
+			diff[k] = js.Undefined
+		}
+	}
+
+	return diff
+}
+
 // ClassDef is used to create custom React components.
 type ClassDef map[string]interface{}
 
@@ -184,5 +340,5 @@ func (def ClassDef) SetMethod(name string, f func(this *js.Object, props, state
 //
 // See: https://reactjs.org/docs/react-without-es6.html
 func CreateClass(def ClassDef) *js.Object {
-	return CreateReactClass.Invoke(def)
+	return CreateReactClass.Invoke(def.withPropValidator())
 }
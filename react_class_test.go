@@ -0,0 +1,56 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+func TestStateDiff(t *testing.T) {
+
+	obj := js.Global.Get("Object").New()
+
+	tests := []struct {
+		name string
+		prev map[string]interface{}
+		next map[string]interface{}
+		want map[string]interface{}
+	}{
+		{"both nil", nil, nil, map[string]interface{}{}},
+		{"both empty", map[string]interface{}{}, map[string]interface{}{}, map[string]interface{}{}},
+		{"equal", map[string]interface{}{"a": 1, "b": "x"}, map[string]interface{}{"a": 1, "b": "x"}, map[string]interface{}{}},
+		{"changed", map[string]interface{}{"a": 1, "b": "x"}, map[string]interface{}{"a": 2, "b": "x"}, map[string]interface{}{"a": 2}},
+		{"added", map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1, "b": "x"}, map[string]interface{}{"b": "x"}},
+		{"added to nil", nil, map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1}},
+		{"removed", map[string]interface{}{"a": 1, "b": "x"}, map[string]interface{}{"a": 1}, map[string]interface{}{"b": js.Undefined}},
+		{"removed to nil", map[string]interface{}{"a": 1}, nil, map[string]interface{}{"a": js.Undefined}},
+		{"nil value", map[string]interface{}{"a": 1}, map[string]interface{}{"a": nil}, map[string]interface{}{"a": nil}},
+		{"equal nested", map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, 2}}},
+			map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, 2}}}, map[string]interface{}{}},
+		{"changed nested", map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, 2}}},
+			map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, 3}}},
+			map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{1, 3}}}},
+		{"same object", map[string]interface{}{"a": obj}, map[string]interface{}{"a": obj}, map[string]interface{}{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := stateDiff(tc.prev, tc.next); !reflect.DeepEqual(diff, tc.want) {
+				t.Errorf("expected %#v, got: %#v", tc.want, diff)
+			}
+		})
+	}
+
+	// Objects are compared by reference
+	if diff := stateDiff(map[string]interface{}{"a": obj}, map[string]interface{}{"a": js.Global.Get("Object").New()}); len(diff) != 1 {
+		t.Errorf("expected a different object to be in the diff, got: %#v", diff)
+	}
+}
@@ -129,12 +129,39 @@ func (s *SyntheticEvent) Type() string {
 	return s.O.Get("type").String()
 }
 
+// TargetValue returns the value of the target (eg. the text of an input element).
+//
+// See: https://reactjs.org/docs/forms.html#controlled-components
+func (s *SyntheticEvent) TargetValue() string {
+	return s.O.Get("target").Get("value").String()
+}
+
+// Key returns the key that was pressed for keyboard events.
+//
+// See: https://reactjs.org/docs/events.html#keyboard-events
+func (s *SyntheticEvent) Key() string {
+	return s.O.Get("key").String()
+}
+
+// RelatedTarget returns the element receiving focus for blur events (or losing focus for focus events).
+// It returns nil if focus moved outside the document.
+//
+// See: https://reactjs.org/docs/events.html#focus-events
+func (s *SyntheticEvent) RelatedTarget() *js.Object {
+	rt := s.O.Get("relatedTarget")
+	if rt == js.Undefined {
+		return nil
+	}
+	return rt
+}
+
 // Persist is used if you want to access properties in an asynchronous way.
 //
 // See: https://reactjs.org/docs/events.html#event-pooling
 func (s *SyntheticEvent) Persist() *SyntheticEvent {
-	p := s.O.Call("persist")
-	return &SyntheticEvent{p}
+	// persist() returns undefined
+	s.O.Call("persist")
+	return s
 }
 
 // SetEventHandler allows a custom event handler to be attached.
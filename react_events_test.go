@@ -0,0 +1,85 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+type eventButton struct {
+	Label   string                `react:"label"`
+	OnClick func(*SyntheticEvent) `react:"onClick"`
+}
+
+type eventProps struct {
+	OnSubmit func(*SyntheticEvent) `react:"onSubmit"`
+	NilFunc  func(*SyntheticEvent) `react:"nilFunc,omitempty"`
+	Buttons  []eventButton         `react:"buttons"`
+}
+
+// fakeEvent returns a javascript object that records calls to preventDefault.
+func fakeEvent(prevented *int) *js.Object {
+	e := js.Global.Get("Object").New()
+	e.Set("type", "click")
+	e.Set("preventDefault", func() {
+		*prevented++
+	})
+	return e
+}
+
+func TestSToMapEventHandlers(t *testing.T) {
+
+	var got []string
+	handler := func(name string) func(*SyntheticEvent) {
+		return func(e *SyntheticEvent) {
+			got = append(got, name+":"+e.O.Get("type").String())
+			e.PreventDefault()
+		}
+	}
+
+	mp := SToMap(eventProps{
+		OnSubmit: handler("submit"),
+		Buttons:  []eventButton{{"a", handler("a")}, {"b", handler("b")}},
+	})
+
+	if _, exists := mp["nilFunc"]; exists {
+		t.Errorf("expected the nil handler to be omitted: %#v", mp)
+	}
+
+	// The handlers are wrapped into functions that receive the raw event
+	call := func(v interface{}) {
+		t.Helper()
+		fn, ok := v.(func(*js.Object))
+		if !ok {
+			t.Fatalf("expected a func(*js.Object), got: %T", v)
+		}
+
+		prevented := 0
+		fn(fakeEvent(&prevented))
+		if prevented != 1 {
+			t.Errorf("expected preventDefault to be called once, got %d", prevented)
+		}
+	}
+
+	call(mp["onSubmit"])
+
+	// Handlers inside slices of structs are also wrapped
+	buttons, ok := mp["buttons"].([]interface{})
+	if !ok || len(buttons) != 2 {
+		t.Fatalf("unexpected buttons: %#v", mp["buttons"])
+	}
+	for _, b := range buttons {
+		call(b.(map[string]interface{})["onClick"])
+	}
+
+	if len(got) != 3 || got[0] != "submit:click" || got[1] != "a:click" || got[2] != "b:click" {
+		t.Errorf("unexpected calls: %v", got)
+	}
+}
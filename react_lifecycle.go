@@ -122,7 +122,7 @@ func (def ClassDef) GetSnapshotBeforeUpdate(f func(this *js.Object, prevProps, p
 		if ret == nil {
 			return nil
 		} else if isStruct(ret) {
-			return convertStruct(ret)
+			return convertStruct(ret, defaultOptions)
 		} else {
 			return ret
 		}
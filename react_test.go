@@ -0,0 +1,89 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test in Node.js with react, react-dom (16 or 17)
+// and jsdom installed.
+
+func setupDOM(t testing.TB) *js.Object {
+	if js.Global.Get("document") == js.Undefined {
+		dom := js.Global.Call("require", "jsdom").Get("JSDOM").New(`<div id="app"></div>`)
+		js.Global.Set("window", dom.Get("window"))
+		js.Global.Set("document", dom.Get("window").Get("document"))
+	}
+
+	// react-dom checks for a DOM when it is loaded
+	React = js.Global.Call("require", "react")
+	ReactDOM = js.Global.Call("require", "react-dom")
+
+	container := js.Global.Get("document").Call("createElement", "div")
+	t.Cleanup(func() {
+		ReactDOM.Call("unmountComponentAtNode", container)
+	})
+	return container
+}
+
+func TestBatchedUpdates(t *testing.T) {
+	container := setupDOM(t)
+
+	renders := 0
+	var setA, setB func(interface{})
+
+	component := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		renders++
+		_, setA = useState(0)
+		_, setB = useState(0)
+		return nil
+	})
+
+	ReactDOM.Call("render", JSX(component, nil), container)
+	if renders != 1 {
+		t.Fatalf("expected 1 render after mounting, got %d", renders)
+	}
+
+	renders = 0
+	BatchedUpdates(func() {
+		setA(1)
+		setB(1)
+	})
+
+	if renders != 1 {
+		t.Errorf("expected 1 render after batched updates, got %d", renders)
+	}
+}
+
+func TestForwardRefRenderProps(t *testing.T) {
+	container := setupDOM(t)
+
+	var got map[string]interface{}
+	fancyInput := ForwardRef(func(props map[string]interface{}, ref *js.Object) *js.Object {
+		got = props
+		return JSX("input", map[string]interface{}{"ref": ref, "placeholder": props["placeholder"]})
+	})
+
+	ref := CreateRefObject()
+	ReactDOM.Call("render", JSX(fancyInput, map[string]interface{}{"ref": ref, "placeholder": "Name", "size": 3}, JSX("span", nil)), container)
+
+	if got["placeholder"] != "Name" || got["size"] != float64(3) {
+		t.Errorf("unexpected props: %#v", got)
+	}
+
+	// React elements are not converted into maps
+	children, ok := got["children"].(*js.Object)
+	if !ok || children.Get("$$typeof") == js.Undefined {
+		t.Errorf("expected children to be a React element, got: %#v", got["children"])
+	}
+
+	if current := ref.Get("current"); current == nil || current.Get("tagName").String() != "INPUT" {
+		t.Errorf("expected the ref to be set to the input")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+type realtimeState[T any] struct {
+	value   T
+	version int
+}
+
+// UseRealtime is a hook that binds a component to a realtime data source
+// (eg. Firebase's onSnapshot, Supabase realtime or a WebSocket). subscribe is
+// called when the component mounts. It must call onChange whenever the data changes
+// and return a function that unsubscribes, which is called when the component unmounts.
+// The latest value is returned. Before the first change, the zero value of T is returned.
+//
+// Example:
+//
+//  msg := react.UseRealtime(func(onChange func(string)) func() {
+//      ws := js.Global.Get("WebSocket").New("wss://example.com/feed")
+//      ws.Set("onmessage", func(e *js.Object) {
+//          onChange(e.Get("data").String())
+//      })
+//      return func() {
+//          ws.Call("close")
+//      }
+//  })
+//
+func UseRealtime[T any](subscribe func(onChange func(T)) func()) T {
+
+	st := useGoRef(func() interface{} {
+		return &realtimeState[T]{}
+	}).(*realtimeState[T])
+
+	_, setVersion := useState(0)
+
+	useEffect(func() func() {
+		unmounted := false
+
+		unsubscribe := subscribe(func(value T) {
+			if unmounted {
+				return
+			}
+			st.value = value
+			st.version++
+			setVersion(st.version)
+		})
+
+		return func() {
+			unmounted = true
+			if unsubscribe != nil {
+				unsubscribe()
+			}
+		}
+	}, []interface{}{})
+
+	return st.value
+}
@@ -0,0 +1,98 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// box allows any Go value to pass through React without being
+// converted into a javascript value.
+type box struct {
+	v interface{}
+}
+
+func wrapBox(v interface{}) *js.Object {
+	return js.MakeWrapper(&box{v})
+}
+
+func unwrapBox(o *js.Object) interface{} {
+	return o.Interface().(*box).v
+}
+
+// UseReducer is a hook that wraps React's useReducer. It is an alternative to
+// useState that is preferable for complex state transitions. The state and actions
+// are kept as Go values, so reducer receives exactly what was passed to dispatch.
+//
+// dispatch can be safely called from asynchronous callbacks (timers, fetch handlers etc).
+// If reducer returns the current state (compared using ==), the re-render is skipped.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#usereducer
+func UseReducer(reducer func(state, action interface{}) interface{}, initialState interface{}) (state interface{}, dispatch func(action interface{})) {
+
+	jsReducer := func(prevState, action *js.Object) *js.Object {
+		prev := unwrapBox(prevState)
+		next := reducer(prev, unwrapBox(action))
+		if sameValue(prev, next) {
+			// Bail out without re-rendering
+			return prevState
+		}
+		return wrapBox(next)
+	}
+
+	res := React.Call("useReducer", jsReducer, wrapBox(initialState))
+
+	jsDispatch := res.Index(1)
+	return unwrapBox(res.Index(0)), func(action interface{}) {
+		jsDispatch.Invoke(wrapBox(action))
+	}
+}
+
+// UseReducerTyped is the same as UseReducer except with concrete state and action types.
+//
+// Example:
+//
+//  count, dispatch := react.UseReducerTyped(func(state int, action string) int {
+//      switch action {
+//      case "increment":
+//          return state + 1
+//      }
+//      return state
+//  }, 0)
+//
+func UseReducerTyped[S, A any](reducer func(state S, action A) S, initialState S) (S, func(A)) {
+
+	state, dispatch := UseReducer(func(state, action interface{}) interface{} {
+		s, _ := state.(S)
+		a, _ := action.(A)
+		return reducer(s, a)
+	}, initialState)
+
+	s, _ := state.(S)
+	return s, func(action A) {
+		dispatch(action)
+	}
+}
+
+// sameValue returns true if a == b. Values that are not comparable
+// are never the same.
+func sameValue(a, b interface{}) (same bool) {
+	defer func() {
+		if recover() != nil {
+			// comparing uncomparable values (eg. inside an interface field)
+			same = false
+		}
+	}()
+
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+
+	return a == b
+}
@@ -0,0 +1,108 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Ref is a typed wrapper of a React ref. It can be assigned to the "ref" prop of an
+// element by a struct field (eg. `react:"ref"`) when the props are converted using SToMap.
+//
+// See: https://reactjs.org/docs/refs-and-the-dom.html
+type Ref[T any] struct {
+	// O is the underlying ref. Its current property can be accessed directly for
+	// imperative DOM access (eg. focus, scroll and canvas).
+	O *js.Object
+}
+
+// refObject is implemented by Ref so that SToMap can detect refs of any type.
+type refObject interface {
+	refObject() *js.Object
+}
+
+func (r *Ref[T]) refObject() *js.Object {
+	if r == nil {
+		return nil
+	}
+	return r.O
+}
+
+// CreateRef will create a Ref.
+//
+// Example:
+//
+//  type InputProps struct {
+//      Ref *react.Ref[*js.Object] `react:"ref"`
+//  }
+//
+//  inputRef := react.CreateRef[*js.Object]()
+//  react.JSX("input", &InputProps{Ref: inputRef})
+//
+//  inputRef.Current().Call("focus")
+//
+// See: https://reactjs.org/docs/refs-and-the-dom.html
+func CreateRef[T any]() *Ref[T] {
+	return &Ref[T]{O: CreateRefObject()}
+}
+
+// UseRef is a hook that returns a Ref whose value persists for the full
+// lifetime of the component. initial is only used on the first render.
+//
+// See: https://reactjs.org/docs/hooks-reference.html#useref
+func UseRef[T any](initial T) *Ref[T] {
+	return &Ref[T]{O: React.Call("useRef", refValue(initial))}
+}
+
+// refValue converts v so that it can be stored in the current property of a ref.
+func refValue(v interface{}) interface{} {
+	if _, ok := v.(*js.Object); !ok && isStruct(v) {
+		return SToMap(v)
+	}
+	return v
+}
+
+// Current returns the value of the ref (eg. the DOM element it is attached to).
+// If T is a struct, the value is unmarshaled using UnmarshalStruct. The zero value
+// is returned if the ref is empty or its value can't be converted to T.
+func (r *Ref[T]) Current() T {
+	var out T
+
+	cur := r.O.Get("current")
+	if cur == nil || cur == js.Undefined {
+		return out
+	}
+
+	if p, ok := interface{}(&out).(**js.Object); ok {
+		*p = cur
+		return out
+	}
+
+	val := cur.Interface()
+
+	if isStruct(out) {
+		if mp, ok := val.(map[string]interface{}); ok {
+			UnmarshalStruct(mp, &out)
+		}
+		return out
+	}
+
+	rv := reflect.ValueOf(val)
+	t := reflect.TypeOf(&out).Elem()
+	switch {
+	case !rv.IsValid():
+	case rv.Type().AssignableTo(t):
+		reflect.ValueOf(&out).Elem().Set(rv)
+	case rv.Type().ConvertibleTo(t):
+		// eg. javascript numbers are float64
+		reflect.ValueOf(&out).Elem().Set(rv.Convert(t))
+	}
+	return out
+}
+
+// SetCurrent sets the value of the ref. Structs are converted using SToMap.
+func (r *Ref[T]) SetCurrent(v T) {
+	r.O.Set("current", refValue(v))
+}
@@ -0,0 +1,89 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// applyRole makes an element behave like the given role. It is used for
+// the "role" tag option, which is placed on the onClick handler.
+//
+// Example:
+//
+//  type Props struct {
+//      OnClick *js.Object `react:"onClick,role=button"`
+//  }
+//
+// The role attribute is added and the element is made focusable (tabIndex=0) unless
+// they are already set. For the button role, an onKeyDown handler is added that fires the
+// click handler when Enter or Space is pressed. For the link role, only Enter is used.
+// An existing onKeyDown handler is called first.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/Accessibility/ARIA/Roles/button_role
+func applyRole(out map[string]interface{}, role string, clickKey string) {
+
+	if _, exists := out["role"]; !exists {
+		out["role"] = role
+	}
+
+	_, exists1 := out["tabIndex"]
+	_, exists2 := out["tabindex"]
+	if !exists1 && !exists2 {
+		out["tabIndex"] = 0
+	}
+
+	var keys []string
+	switch role {
+	case "button":
+		keys = []string{"Enter", " ", "Spacebar"}
+	case "link":
+		keys = []string{"Enter"}
+	default:
+		return
+	}
+
+	click := toJSFunc(out[clickKey])
+	if click == nil {
+		return
+	}
+
+	prev := toJSFunc(out["onKeyDown"])
+
+	out["onKeyDown"] = func(e *js.Object) {
+		if prev != nil {
+			prev.Invoke(e)
+		}
+
+		if e.Call("isDefaultPrevented").Bool() {
+			return
+		}
+
+		key := e.Get("key").String()
+		for _, k := range keys {
+			if key == k {
+				// Prevent space from scrolling the page
+				e.Call("preventDefault")
+				click.Invoke(e)
+				return
+			}
+		}
+	}
+}
+
+// toJSFunc converts a Go func or a *js.Object to a javascript function.
+// nil is returned if fn is nil.
+func toJSFunc(fn interface{}) *js.Object {
+	if fn == nil || jsObjectIsNil(fn) {
+		return nil
+	}
+
+	if o, ok := fn.(*js.Object); ok {
+		return o
+	}
+
+	// Let gopherjs externalize the Go func
+	o := js.Global.Get("Object").New()
+	o.Set("fn", fn)
+	return o.Get("fn")
+}
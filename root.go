@@ -0,0 +1,121 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// rootKey is the property of the container that stores its Root.
+const rootKey = "__reactGoRoot"
+
+// Root is used to render elements into a container.
+//
+// See: https://reactjs.org/docs/react-dom-client.html
+type Root struct {
+	// O is the React 18 root. It is nil when the legacy API is used.
+	O *js.Object
+
+	container *js.Object
+	hydrate   bool
+}
+
+// CreateRoot creates a Root for container. Calling it again for the same
+// container returns the same Root.
+//
+// If ReactDOM.createRoot is not available (React 17 and earlier), the legacy
+// ReactDOM.render API is used instead. When Development is true, a warning is printed to the console.
+//
+// Example:
+//
+//  root := react.CreateRoot(react.GetElementByID("app"))
+//  root.Render(react.JSX(App, nil))
+//
+// See: https://reactjs.org/docs/react-dom-client.html#createroot
+func CreateRoot(container *js.Object) *Root {
+
+	if existing := container.Get(rootKey); existing != js.Undefined && existing != nil {
+		return existing.Interface().(*Root)
+	}
+
+	r := &Root{container: container}
+	if ReactDOM.Get("createRoot") != js.Undefined {
+		r.O = ReactDOM.Call("createRoot", container)
+	} else {
+		warnOnce("react: ReactDOM.createRoot is not available. Falling back to the legacy API.")
+	}
+
+	container.Set(rootKey, js.MakeWrapper(r))
+	return r
+}
+
+// HydrateRoot creates a Root for container and attaches event listeners to the markup
+// that was rendered on the server. initialChildren must match the server's markup.
+//
+// If ReactDOM.hydrateRoot is not available (React 17 and earlier), the legacy
+// ReactDOM.hydrate API is used instead. When Development is true, a warning is printed to the console.
+//
+// See: https://reactjs.org/docs/react-dom-client.html#hydrateroot
+func HydrateRoot(container *js.Object, initialChildren interface{}) *Root {
+	r, _ := hydrateRoot(container, initialChildren, nil)
+	return r
+}
+
+// hydrateRoot is the same as HydrateRoot except that callback is passed to the
+// legacy API, whose result (the component instance) is returned.
+func hydrateRoot(container *js.Object, initialChildren interface{}, callback func()) (*Root, *js.Object) {
+
+	r := &Root{container: container}
+	var instance *js.Object
+	if ReactDOM.Get("hydrateRoot") != js.Undefined {
+		r.O = ReactDOM.Call("hydrateRoot", container, initialChildren)
+	} else {
+		warnOnce("react: ReactDOM.hydrateRoot is not available. Falling back to the legacy API.")
+		r.hydrate = true
+		instance = r.render(initialChildren, callback)
+	}
+
+	container.Set(rootKey, js.MakeWrapper(r))
+	return r, instance
+}
+
+// Render renders element into the container.
+func (r *Root) Render(element interface{}) {
+	r.render(element, nil)
+}
+
+// render renders element. callback is called after the element is rendered.
+// The component instance is returned when the legacy API is used.
+func (r *Root) render(element interface{}, callback func()) *js.Object {
+
+	if r.O != nil {
+		r.O.Call("render", element)
+		if callback != nil {
+			// React 18 roots don't accept a callback
+			js.Global.Call("setTimeout", callback, 0)
+		}
+		return nil
+	}
+
+	method := "render"
+	if r.hydrate {
+		// Only the first render hydrates
+		method = "hydrate"
+		r.hydrate = false
+	}
+
+	if callback != nil {
+		return ReactDOM.Call(method, element, r.container, callback)
+	}
+	return ReactDOM.Call(method, element, r.container)
+}
+
+// Unmount removes the rendered elements from the container.
+func (r *Root) Unmount() {
+	if r.O != nil {
+		r.O.Call("unmount")
+	} else {
+		ReactDOM.Call("unmountComponentAtNode", r.container)
+	}
+	r.container.Set(rootKey, js.Undefined)
+}
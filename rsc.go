@@ -0,0 +1,284 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react/forks/encoding/json"
+)
+
+// SerializeServerProps encodes props using a subset of the React Server Components
+// wire format. The output is valid JSON.
+//
+// Structs are converted using the same rules as SToMap. Strings beginning with "$"
+// are escaped as "$$". NaN, ±Inf and time.Time values are encoded as "$NaN",
+// "$Infinity", "$-Infinity" and "$D<ISO date>". React elements with a host type
+// (eg. "div") are encoded as ["$", type, key, props].
+//
+// An error is returned for values that can't be serialized, such as functions,
+// channels, promises and elements of non-host components (client references
+// are not supported).
+//
+// See: https://github.com/reactjs/rfcs/blob/main/text/0188-server-components.md
+func SerializeServerProps(props interface{}) ([]byte, error) {
+	v, err := toServerValue("", props)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// DeserializeServerProps parses data produced by SerializeServerProps.
+// Encoded React elements are recreated using React.createElement.
+func DeserializeServerProps(data []byte) (interface{}, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return fromServerValue("", v)
+}
+
+func pathError(path, msg string) error {
+	if path == "" {
+		return errors.New("react: " + msg)
+	}
+	return errors.New("react: " + path + ": " + msg)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// toServerValue converts v into a tree of json encodable values.
+func toServerValue(path string, v interface{}) (interface{}, error) {
+
+	if v == nil || jsObjectIsNil(v) {
+		return nil, nil
+	}
+
+	switch x := v.(type) {
+	case *js.Object:
+		return jsToServerValue(path, x)
+	case string:
+		if strings.HasPrefix(x, "$") {
+			return "$" + x, nil
+		}
+		return x, nil
+	case time.Time:
+		return "$D" + x.UTC().Format("2006-01-02T15:04:05.000Z"), nil
+	case Set:
+		out := map[string]interface{}{}
+		for attr, val := range x {
+			out[attr], _ = toServerValue("", val)
+		}
+		return out, nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		switch {
+		case math.IsNaN(f):
+			return "$NaN", nil
+		case math.IsInf(f, 1):
+			return "$Infinity", nil
+		case math.IsInf(f, -1):
+			return "$-Infinity", nil
+		}
+		return f, nil
+	case reflect.String:
+		return toServerValue(path, rv.String())
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return toServerValue(path, rv.Elem().Interface())
+	case reflect.Struct:
+		return toServerValue(path, convertStruct(v, defaultOptions))
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, pathError(path, "map keys must be strings")
+		}
+		if rv.IsNil() {
+			return nil, nil
+		}
+		out := map[string]interface{}{}
+		for _, key := range rv.MapKeys() {
+			k := key.String()
+			val, err := toServerValue(joinPath(path, k), rv.MapIndex(key).Interface())
+			if err != nil {
+				return nil, err
+			}
+			out[k] = val
+		}
+		return out, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil, nil
+		}
+		out := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			val, err := toServerValue(joinPath(path, strconv.Itoa(i)), rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, val)
+		}
+		return out, nil
+	}
+
+	return nil, pathError(path, "can't serialize value of kind "+rv.Kind().String())
+}
+
+// jsToServerValue converts a native javascript value.
+func jsToServerValue(path string, o *js.Object) (interface{}, error) {
+
+	if o == js.Undefined {
+		return "$undefined", nil
+	}
+
+	switch o.Get("constructor") {
+	case js.Global.Get("Function"):
+		return nil, pathError(path, "can't serialize function")
+	case js.Global.Get("Promise"):
+		return nil, pathError(path, "can't serialize promise")
+	}
+
+	if o.Get("$$typeof") == js.Global.Get("Symbol").Call("for", "react.element") {
+		typ := o.Get("type")
+		if typ.Get("constructor") != js.Global.Get("String") {
+			return nil, pathError(path, "only elements of host components can be serialized")
+		}
+
+		var key interface{}
+		if k := o.Get("key"); k != nil && k != js.Undefined {
+			key = k.String()
+		}
+
+		props, err := jsToServerValue(joinPath(path, "props"), o.Get("props"))
+		if err != nil {
+			return nil, err
+		}
+
+		return []interface{}{"$", typ.String(), key, props}, nil
+	}
+
+	if js.Global.Get("Array").Call("isArray", o).Bool() {
+		out := make([]interface{}, 0, o.Length())
+		for i := 0; i < o.Length(); i++ {
+			val, err := jsToServerValue(joinPath(path, strconv.Itoa(i)), o.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, val)
+		}
+		return out, nil
+	}
+
+	if o.Get("constructor") == js.Global.Get("Object") {
+		out := map[string]interface{}{}
+		keys := js.Global.Get("Object").Call("keys", o)
+		for i := 0; i < keys.Length(); i++ {
+			k := keys.Index(i).String()
+			val, err := jsToServerValue(joinPath(path, k), o.Get(k))
+			if err != nil {
+				return nil, err
+			}
+			out[k] = val
+		}
+		return out, nil
+	}
+
+	// Primitive values
+	return toServerValue(path, o.Interface())
+}
+
+// fromServerValue reverses toServerValue.
+func fromServerValue(path string, v interface{}) (interface{}, error) {
+
+	switch x := v.(type) {
+	case string:
+		if !strings.HasPrefix(x, "$") {
+			return x, nil
+		}
+
+		switch {
+		case strings.HasPrefix(x, "$$"):
+			return x[1:], nil
+		case x == "$undefined":
+			return nil, nil
+		case x == "$NaN":
+			return math.NaN(), nil
+		case x == "$Infinity":
+			return math.Inf(1), nil
+		case x == "$-Infinity":
+			return math.Inf(-1), nil
+		case strings.HasPrefix(x, "$D"):
+			t, err := time.Parse(time.RFC3339, x[2:])
+			if err != nil {
+				return nil, pathError(path, err.Error())
+			}
+			return t, nil
+		}
+		return nil, pathError(path, "unsupported reference "+x)
+	case []interface{}:
+		if len(x) == 4 && x[0] == "$" {
+			typ, ok := x[1].(string)
+			if !ok {
+				return nil, pathError(path, "invalid element type")
+			}
+			props, err := fromServerValue(joinPath(path, "props"), x[3])
+			if err != nil {
+				return nil, err
+			}
+			mp, _ := props.(map[string]interface{})
+			if mp == nil {
+				mp = map[string]interface{}{}
+			}
+			if x[2] != nil {
+				mp["key"] = x[2]
+			}
+			return React.Call("createElement", typ, mp), nil
+		}
+
+		out := make([]interface{}, 0, len(x))
+		for i := range x {
+			val, err := fromServerValue(joinPath(path, strconv.Itoa(i)), x[i])
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, val)
+		}
+		return out, nil
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k := range x {
+			val, err := fromServerValue(joinPath(path, k), x[k])
+			if err != nil {
+				return nil, err
+			}
+			out[k] = val
+		}
+		return out, nil
+	}
+
+	return v, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+)
+
+// sanitizer is the name of the javascript function set by RegisterSanitizer.
+var sanitizer string
+
+// RegisterSanitizer sets the javascript function used by the "sanitize" tag option.
+// When a struct is converted by SToMap, the value of a string field with the option
+// is passed to the function and the result is set as the dangerouslySetInnerHTML prop.
+//
+// If no sanitizer has been registered (or it throws), SToMap panics with a *ConversionError
+// (SToMapE returns it) rather than inserting unsanitized HTML. A nil *string field is omitted.
+//
+// Example:
+//
+//  react.RegisterSanitizer("DOMPurify.sanitize")
+//
+//  type Props struct {
+//      Comment string `react:",sanitize"`
+//  }
+//
+// See: https://github.com/cure53/DOMPurify
+func RegisterSanitizer(jsFuncName string) {
+	sanitizer = jsFuncName
+}
+
+// sanitizeHTML returns the value of a string field after it has been sanitized.
+// ok is false if the field is a nil pointer.
+func sanitizeHTML(v reflect.Value) (_ map[string]interface{}, ok bool) {
+
+	if sanitizer == "" {
+		panic(&ConversionError{Kind: v.Kind(), Msg: "sanitize tag option used but no sanitizer is registered"})
+	}
+
+	v, isNil := indirect(v)
+	if isNil {
+		return nil, false
+	}
+	if v.Kind() != reflect.String {
+		panic(&ConversionError{Kind: v.Kind(), Msg: "sanitize field must be a string"})
+	}
+
+	out, err := JSFn(sanitizer, v.String())
+	if err != nil {
+		panic(&ConversionError{Kind: v.Kind(), Msg: "sanitize: " + err.Error()})
+	}
+
+	return DangerouslySetInnerHTML(out.String()), true
+}
@@ -0,0 +1,191 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ScriptOptions configures the tag injected by LoadScript and LoadStylesheet.
+type ScriptOptions struct {
+	// Async sets the async attribute. It is ignored for stylesheets.
+	Async bool
+
+	// Defer sets the defer attribute. It is ignored for stylesheets.
+	Defer bool
+
+	// Nonce sets the nonce attribute, which is required when a
+	// Content-Security-Policy is in effect.
+	Nonce string
+
+	// Attributes are additional attributes added to the tag (eg. crossorigin, integrity).
+	Attributes map[string]string
+}
+
+// resource records the status of an injected script or stylesheet.
+type resource struct {
+	promise *js.Object
+	loaded  bool
+	err     error
+}
+
+// resources is keyed by tag name and url.
+var resources = map[string]*resource{}
+
+// LoadScript injects a <script> tag into the document's head. The returned promise
+// resolves with the tag when the script has loaded and rejects if it fails to load.
+// It can be used with Await.
+//
+// Scripts are deduplicated by src, so concurrent callers share the same tag
+// and promise. If a script previously failed to load, calling LoadScript again
+// will retry.
+//
+// Example:
+//
+//  p, _ := react.LoadScript("https://js.stripe.com/v3/", react.ScriptOptions{Async: true})
+//  go func() {
+//      _, err := react.Await(p)
+//  }()
+//
+func LoadScript(src string, opts ...ScriptOptions) (*js.Object, error) {
+	return loadResource("script", src, opts...)
+}
+
+// LoadStylesheet injects a <link rel="stylesheet"> tag into the document's head.
+// It behaves the same way as LoadScript.
+func LoadStylesheet(href string, opts ...ScriptOptions) (*js.Object, error) {
+	return loadResource("link", href, opts...)
+}
+
+// UseScript is a hook that loads a script using LoadScript. It returns true when the
+// script has loaded so a component can render a placeholder until the SDK is ready.
+// If the script fails to load, an error is returned.
+//
+// See: https://reactjs.org/docs/hooks-intro.html
+func UseScript(src string, opts ...ScriptOptions) (loaded bool, err error) {
+
+	if IsNative() {
+		return false, ErrNotSupported
+	}
+
+	const (
+		loading = iota
+		ready
+		failed
+	)
+
+	initial := loading
+	if r, exists := resources["script:"+src]; exists {
+		if r.loaded {
+			initial = ready
+		} else if r.err != nil {
+			initial = failed
+		}
+	}
+
+	status, setStatus := useState(initial)
+
+	useEffect(func() func() {
+		p, err := LoadScript(src, opts...)
+		if err != nil {
+			setStatus(failed)
+			return nil
+		}
+
+		unmounted := false
+		p.Call("then", func() {
+			if !unmounted {
+				setStatus(ready)
+			}
+		}, func() {
+			if !unmounted {
+				setStatus(failed)
+			}
+		})
+
+		return func() {
+			unmounted = true
+		}
+	}, []interface{}{src})
+
+	switch status.Int() {
+	case ready:
+		return true, nil
+	case failed:
+		if r, exists := resources["script:"+src]; exists && r.err != nil {
+			return false, r.err
+		}
+		return false, errors.New("UseScript: failed to load " + src)
+	default:
+		return false, nil
+	}
+}
+
+func loadResource(tagName, url string, opts ...ScriptOptions) (_ *js.Object, rErr error) {
+	if IsNative() {
+		return nil, ErrNotSupported
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = err
+		}
+	}()
+
+	key := tagName + ":" + url
+
+	if r, exists := resources[key]; exists && r.err == nil {
+		return r.promise, nil
+	}
+
+	document := js.Global.Get("document")
+	if document == js.Undefined {
+		return nil, errors.New("document is not available")
+	}
+
+	tag := document.Call("createElement", tagName)
+	if tagName == "script" {
+		tag.Set("src", url)
+		if len(opts) > 0 {
+			tag.Set("async", opts[0].Async)
+			tag.Set("defer", opts[0].Defer)
+		}
+	} else {
+		tag.Set("rel", "stylesheet")
+		tag.Set("href", url)
+	}
+
+	if len(opts) > 0 {
+		if opts[0].Nonce != "" {
+			tag.Set("nonce", opts[0].Nonce)
+		}
+		for attr, val := range opts[0].Attributes {
+			tag.Call("setAttribute", attr, val)
+		}
+	}
+
+	r := &resource{}
+	r.promise = js.Global.Get("Promise").New(func(resolve, reject *js.Object) {
+		tag.Call("addEventListener", "load", func(e *js.Object) {
+			r.loaded = true
+			resolve.Invoke(tag)
+		})
+		tag.Call("addEventListener", "error", func(e *js.Object) {
+			r.err = errors.New("failed to load " + url)
+			// Remove the tag so that a subsequent call can retry
+			tag.Call("remove")
+			reject.Invoke(js.Global.Get("Error").New(r.err.Error()))
+		})
+	})
+
+	resources[key] = r
+	document.Get("head").Call("appendChild", tag)
+
+	return r.promise, nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !native
+// +build !native
+
+package react
+
+import (
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ScrollRestorationMaxEntries is the maximum number of locations whose scroll
+// positions are stored. The least recently saved locations are removed first.
+var ScrollRestorationMaxEntries = 50
+
+// ScrollRestorationTimeout is how long RestoreScroll waits for the content to be tall
+// (or wide) enough to restore the position. The position is then restored as far as possible.
+var ScrollRestorationTimeout = 2 * time.Second
+
+// windowScrollID identifies the window in the stored positions.
+const windowScrollID = ""
+
+type scrollPosition struct {
+	x, y float64
+}
+
+var (
+	// scrollPositions stores the positions of the containers keyed by location key.
+	scrollPositions = map[string]map[string]scrollPosition{}
+	scrollKeys      []string // oldest first
+
+	// scrollContainers are registered with UseScrollContainer.
+	scrollContainers = map[string]*js.Object{}
+
+	// scrollRestoring is true while positions are being restored after navigation.
+	scrollRestoring bool
+	scrollPending   int // number of positions waiting to be restored
+)
+
+// scrollLocationKey returns the key of the current location.
+func scrollLocationKey() string {
+	loc := js.Global.Get("location")
+	return loc.Get("pathname").String() + loc.Get("search").String()
+}
+
+// SaveScroll stores the scroll positions of the window and the containers
+// registered with UseScrollContainer under key.
+//
+// It is used by apps that don't use UseScrollRestoration (eg. to save the position
+// before showing a detail view without changing the url).
+func SaveScroll(key string) {
+
+	positions := map[string]scrollPosition{
+		windowScrollID: {js.Global.Get("scrollX").Float(), js.Global.Get("scrollY").Float()},
+	}
+
+	for id, ref := range scrollContainers {
+		if node := ref.Get("current"); node != nil && node != js.Undefined {
+			positions[id] = scrollPosition{node.Get("scrollLeft").Float(), node.Get("scrollTop").Float()}
+		}
+	}
+
+	if _, exists := scrollPositions[key]; exists {
+		for i, k := range scrollKeys {
+			if k == key {
+				scrollKeys = append(scrollKeys[:i:i], scrollKeys[i+1:]...)
+				break
+			}
+		}
+	}
+	scrollKeys = append(scrollKeys, key)
+	scrollPositions[key] = positions
+
+	for len(scrollKeys) > ScrollRestorationMaxEntries && len(scrollKeys) > 0 {
+		delete(scrollPositions, scrollKeys[0])
+		scrollKeys = scrollKeys[1:]
+	}
+}
+
+// RestoreScroll restores the scroll positions stored under key by SaveScroll.
+// If the content is not yet tall enough (eg. a list is still rendering), it waits (using
+// ResizeObserver) for up to ScrollRestorationTimeout.
+func RestoreScroll(key string) {
+	for id, pos := range scrollPositions[key] {
+		if id == windowScrollID {
+			restoreScrollPosition(nil, pos)
+			continue
+		}
+
+		if ref := scrollContainers[id]; ref != nil {
+			if node := ref.Get("current"); node != nil && node != js.Undefined {
+				restoreScrollPosition(node, pos)
+			}
+		}
+	}
+}
+
+// restoreScrollPosition scrolls node (or the window if node is nil) to pos once its content is large enough.
+func restoreScrollPosition(node *js.Object, pos scrollPosition) {
+
+	scroller := node
+	if node == nil {
+		scroller = js.Global.Get("document").Get("documentElement")
+	}
+
+	scrollPending++
+	finished := false
+	scroll := func() {
+		if finished {
+			return
+		}
+		finished = true
+
+		if node == nil {
+			js.Global.Call("scrollTo", pos.x, pos.y)
+		} else {
+			node.Set("scrollLeft", pos.x)
+			node.Set("scrollTop", pos.y)
+		}
+
+		scrollPending--
+		if scrollPending == 0 {
+			scrollRestoring = false
+		}
+	}
+
+	ready := func() bool {
+		maxX := scroller.Get("scrollWidth").Float() - scroller.Get("clientWidth").Float()
+		maxY := scroller.Get("scrollHeight").Float() - scroller.Get("clientHeight").Float()
+		return maxX >= pos.x && maxY >= pos.y
+	}
+
+	if ready() || js.Global.Get("ResizeObserver") == js.Undefined {
+		scroll()
+		return
+	}
+
+	// Wait for the content to grow
+	content := scroller
+	if child := scroller.Get("firstElementChild"); node != nil && child != nil && child != js.Undefined {
+		content = child
+	}
+
+	var timer *js.Object
+	var observer *js.Object
+	done := func() {
+		observer.Call("disconnect")
+		js.Global.Call("clearTimeout", timer)
+		scroll()
+	}
+
+	observer = js.Global.Get("ResizeObserver").New(func() {
+		if ready() {
+			done()
+		}
+	})
+	observer.Call("observe", content)
+	timer = js.Global.Call("setTimeout", done, int64(ScrollRestorationTimeout/time.Millisecond))
+}
+
+// UseScrollContainer is a hook that registers the element of ref as a scrollable container
+// whose position is saved and restored along with the window's. id must be unique and
+// stay the same across page loads.
+//
+// Example:
+//
+//  ref := react.React.Call("useRef", nil)
+//  react.UseScrollContainer("results", ref)
+//
+//  return elements.Div(&elements.DivProps{Ref: ref, Style: &elements.Styles{Overflow: "auto"}}, rows...)
+//
+func UseScrollContainer(id string, ref *js.Object) {
+	useEffect(func() func() {
+		scrollContainers[id] = ref
+		return func() {
+			delete(scrollContainers, id)
+		}
+	}, []interface{}{id, ref})
+}
+
+// UseScrollRestoration is a hook that saves the scroll positions of the window and the
+// containers registered with UseScrollContainer for each location (the url's path and query)
+// and restores them when the user navigates back (or forward) using the browser's history.
+// It should be used once, in the root component.
+//
+// The browser's own scroll restoration is disabled (history.scrollRestoration is set to "manual")
+// while the component is mounted, so the two don't fight.
+//
+// Example:
+//
+//  appComponent := func(props *js.Object) *js.Object {
+//      react.UseScrollRestoration()
+//      ...
+//  }
+//
+func UseScrollRestoration() {
+	useEffect(func() func() {
+
+		history := js.Global.Get("history")
+		original := history.Get("scrollRestoration")
+		if original != js.Undefined {
+			history.Set("scrollRestoration", "manual")
+		}
+
+		// Positions are saved continuously because pushState doesn't fire
+		// an event that can be used to save before the location changes.
+		var frame *js.Object
+		onScroll := func() {
+			if scrollRestoring || frame != nil {
+				return
+			}
+			frame = js.Global.Call("requestAnimationFrame", func() {
+				frame = nil
+				if !scrollRestoring {
+					SaveScroll(scrollLocationKey())
+				}
+			})
+		}
+
+		onPopState := func() {
+			scrollRestoring = true
+			key := scrollLocationKey()
+
+			// Wait for the app to re-render for the new location
+			js.Global.Call("setTimeout", func() {
+				RestoreScroll(key)
+				if scrollPending == 0 {
+					scrollRestoring = false
+				}
+			}, 0)
+		}
+
+		// Scroll events don't bubble, so they are captured to include containers
+		document := js.Global.Get("document")
+		document.Call("addEventListener", "scroll", onScroll, js.M{"capture": true, "passive": true})
+		js.Global.Call("addEventListener", "popstate", onPopState)
+
+		return func() {
+			document.Call("removeEventListener", "scroll", onScroll, js.M{"capture": true})
+			js.Global.Call("removeEventListener", "popstate", onPopState)
+			if frame != nil {
+				js.Global.Call("cancelAnimationFrame", frame)
+			}
+			if original != js.Undefined {
+				history.Set("scrollRestoration", original)
+			}
+		}
+	}, []interface{}{})
+}
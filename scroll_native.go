@@ -0,0 +1,30 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build native
+// +build native
+
+package react
+
+import (
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ScrollRestorationMaxEntries is not used in React Native.
+var ScrollRestorationMaxEntries = 50
+
+// ScrollRestorationTimeout is not used in React Native.
+var ScrollRestorationTimeout = 2 * time.Second
+
+// SaveScroll does nothing in React Native.
+func SaveScroll(key string) {}
+
+// RestoreScroll does nothing in React Native.
+func RestoreScroll(key string) {}
+
+// UseScrollContainer does nothing in React Native.
+func UseScrollContainer(id string, ref *js.Object) {}
+
+// UseScrollRestoration does nothing in React Native.
+func UseScrollRestoration() {}
@@ -0,0 +1,216 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	// ErrNoSelection is returned when the document has no selection.
+	ErrNoSelection = errors.New("react: no selection")
+
+	// ErrSelectionOutside is returned when the selection is not inside the container.
+	ErrSelectionOutside = errors.New("react: selection is outside container")
+)
+
+// NodeNotFoundError is returned by RestoreSelection when a node recorded
+// in the SelectionState no longer exists (usually because it was removed
+// during a re-render).
+type NodeNotFoundError struct {
+	// Path is the path to the node that could not be found.
+	Path []int
+}
+
+// Error implements the error interface.
+func (e *NodeNotFoundError) Error() string {
+	path := ""
+	for i, idx := range e.Path {
+		if i > 0 {
+			path = path + "."
+		}
+		path = path + strconv.Itoa(idx)
+	}
+	return "react: node not found at path [" + path + "]"
+}
+
+// SelectionState records the user's text selection. Nodes are recorded as
+// paths of child indexes relative to a container, so the selection can be
+// restored after the container's DOM has been recreated by a re-render.
+type SelectionState struct {
+	AnchorPath   []int
+	AnchorOffset int
+	FocusPath    []int
+	FocusOffset  int
+}
+
+// Collapsed returns true if the selection is a caret.
+func (s SelectionState) Collapsed() bool {
+	if len(s.AnchorPath) != len(s.FocusPath) || s.AnchorOffset != s.FocusOffset {
+		return false
+	}
+	for i := range s.AnchorPath {
+		if s.AnchorPath[i] != s.FocusPath[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetSelection returns the document's current selection relative to container,
+// which must be a Ref object (see CreateRefObject). ErrNoSelection or ErrSelectionOutside
+// is returned if there is no selection inside the container.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/Selection
+func GetSelection(container *js.Object) (SelectionState, error) {
+
+	if IsNative() {
+		return SelectionState{}, ErrNotSupported
+	}
+
+	root := container.Get("current")
+	if root == nil || root == js.Undefined {
+		return SelectionState{}, ErrSelectionOutside
+	}
+
+	sel := js.Global.Call("getSelection")
+	if sel == nil || sel.Get("rangeCount").Int() == 0 {
+		return SelectionState{}, ErrNoSelection
+	}
+
+	anchorPath, ok := nodePath(root, sel.Get("anchorNode"))
+	if !ok {
+		return SelectionState{}, ErrSelectionOutside
+	}
+
+	focusPath, ok := nodePath(root, sel.Get("focusNode"))
+	if !ok {
+		return SelectionState{}, ErrSelectionOutside
+	}
+
+	return SelectionState{
+		AnchorPath:   anchorPath,
+		AnchorOffset: sel.Get("anchorOffset").Int(),
+		FocusPath:    focusPath,
+		FocusOffset:  sel.Get("focusOffset").Int(),
+	}, nil
+}
+
+// RestoreSelection restores a selection previously returned by GetSelection.
+// A *NodeNotFoundError is returned if the recorded nodes no longer exist.
+func RestoreSelection(container *js.Object, s SelectionState) (rErr error) {
+	if IsNative() {
+		return ErrNotSupported
+	}
+
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = err
+		}
+	}()
+
+	root := container.Get("current")
+	if root == nil || root == js.Undefined {
+		return ErrSelectionOutside
+	}
+
+	anchorNode, err := nodeAtPath(root, s.AnchorPath, s.AnchorOffset)
+	if err != nil {
+		return err
+	}
+
+	focusNode, err := nodeAtPath(root, s.FocusPath, s.FocusOffset)
+	if err != nil {
+		return err
+	}
+
+	sel := js.Global.Call("getSelection")
+	if sel == nil {
+		return ErrNoSelection
+	}
+
+	sel.Call("setBaseAndExtent", anchorNode, s.AnchorOffset, focusNode, s.FocusOffset)
+	return nil
+}
+
+// OnSelectionChange calls fn whenever the document's selection changes.
+// The selection is provided relative to container, with the same errors as GetSelection.
+// The returned function must be called to remove the listener (eg. in componentWillUnmount).
+func OnSelectionChange(container *js.Object, fn func(s SelectionState, err error)) (remove func()) {
+
+	if IsNative() {
+		return func() {}
+	}
+
+	document := js.Global.Get("document")
+
+	listener := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		fn(GetSelection(container))
+		return nil
+	})
+
+	document.Call("addEventListener", "selectionchange", listener)
+
+	return func() {
+		document.Call("removeEventListener", "selectionchange", listener)
+	}
+}
+
+// nodePath returns the child indexes leading from root to node.
+func nodePath(root, node *js.Object) ([]int, bool) {
+
+	path := []int{}
+
+	for node != nil && node != js.Undefined {
+		if node == root {
+			// reverse
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return path, true
+		}
+
+		parent := node.Get("parentNode")
+		if parent == nil {
+			break
+		}
+
+		idx := js.Global.Get("Array").Get("prototype").Get("indexOf").Call("call", parent.Get("childNodes"), node).Int()
+		path = append(path, idx)
+		node = parent
+	}
+
+	return nil, false
+}
+
+// nodeAtPath returns the node at path relative to root. offset must be valid for the node.
+func nodeAtPath(root *js.Object, path []int, offset int) (*js.Object, error) {
+
+	node := root
+	for _, idx := range path {
+		children := node.Get("childNodes")
+		if idx < 0 || idx >= children.Length() {
+			return nil, &NodeNotFoundError{Path: path}
+		}
+		node = children.Index(idx)
+	}
+
+	// Text nodes have a length, while elements have child nodes
+	max := node.Get("childNodes").Length()
+	if node.Get("nodeType").Int() == 3 {
+		max = node.Get("length").Int()
+	}
+
+	if offset < 0 || offset > max {
+		return nil, &NodeNotFoundError{Path: path}
+	}
+
+	return node, nil
+}
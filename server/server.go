@@ -0,0 +1,70 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package server provides bindings to ReactDOMServer for server-side rendering.
+// It must be used in a Node.js environment.
+//
+// See: https://reactjs.org/docs/react-dom-server.html
+package server
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ReactDOMServer points to the ReactDOMServer library. If it is not set,
+// it is loaded using require("react-dom/server") when first used.
+//
+// See: https://www.npmjs.com/package/react-dom
+var ReactDOMServer *js.Object
+
+// reactDOMServer returns ReactDOMServer. It panics if it is not called from Node.js.
+func reactDOMServer() *js.Object {
+
+	process := js.Global.Get("process")
+	if process == js.Undefined || process == nil || process.Get("versions") == js.Undefined || process.Get("versions").Get("node") == js.Undefined {
+		panic("server: ReactDOMServer can only be used in a Node.js environment (not a browser)")
+	}
+
+	if ReactDOMServer == nil || ReactDOMServer == js.Undefined {
+		if global := js.Global.Get("ReactDOMServer"); global != js.Undefined {
+			ReactDOMServer = global
+		} else {
+			ReactDOMServer = js.Global.Call("require", "react-dom/server")
+		}
+	}
+
+	return ReactDOMServer
+}
+
+// RenderToString renders element to its initial HTML. The HTML can be hydrated
+// on the client using react.HydrateRoot.
+//
+// See: https://reactjs.org/docs/react-dom-server.html#rendertostring
+func RenderToString(element interface{}) (string, error) {
+	return render("renderToString", element)
+}
+
+// RenderToStaticMarkup is the same as RenderToString except that the extra DOM
+// attributes that React uses internally are not created. It is useful for
+// generating static pages that will not be hydrated.
+//
+// See: https://reactjs.org/docs/react-dom-server.html#rendertostaticmarkup
+func RenderToStaticMarkup(element interface{}) (string, error) {
+	return render("renderToStaticMarkup", element)
+}
+
+func render(method string, element interface{}) (_ string, rErr error) {
+
+	server := reactDOMServer()
+
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = err
+		}
+	}()
+
+	return server.Call(method, element).String(), nil
+}
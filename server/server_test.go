@@ -0,0 +1,55 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react"
+)
+
+// These tests must be run using gopherjs test in Node.js with react and react-dom installed.
+
+func init() {
+	react.React = js.Global.Call("require", "react")
+}
+
+func TestRenderToStaticMarkup(t *testing.T) {
+
+	element := react.JSX("div", map[string]interface{}{"className": "greeting"}, "Hello World")
+
+	html, err := RenderToStaticMarkup(element)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `<div class="greeting">Hello World</div>`
+	if html != expected {
+		t.Errorf("expected %q, got %q", expected, html)
+	}
+}
+
+func TestRenderToString(t *testing.T) {
+
+	component := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		return react.JSX("p", nil, "Hello "+arguments[0].Get("name").String())
+	})
+
+	html, err := RenderToString(react.JSX(component, map[string]interface{}{"name": "John"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// React 17 and earlier add data-reactroot to the root element
+	html = strings.Replace(html, ` data-reactroot=""`, "", 1)
+
+	expected := `<p>Hello John</p>`
+	if html != expected {
+		t.Errorf("expected %q, got %q", expected, html)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ConnectionStatus is the status of a connection to a server.
+type ConnectionStatus string
+
+const (
+	// ConnectionConnecting means the connection is being established.
+	ConnectionConnecting ConnectionStatus = "connecting"
+	// ConnectionOpen means the connection is open.
+	ConnectionOpen ConnectionStatus = "open"
+	// ConnectionClosed means the connection has been closed and will not be retried.
+	ConnectionClosed ConnectionStatus = "closed"
+)
+
+// MessageEvent is an event received from a server.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/MessageEvent
+type MessageEvent struct {
+	O *js.Object
+
+	Type        string
+	Data        string
+	LastEventID string
+	Origin      string
+}
+
+// SSEProps configures SSEFeed.
+type SSEProps struct {
+	// URL is the url of the event stream.
+	URL string
+
+	// WithCredentials sends cookies for cross-origin requests.
+	WithCredentials bool
+
+	// EventTypes are the named events to listen for. If empty, only
+	// unnamed events ("message") are received.
+	EventTypes []string
+
+	// MaxEvents is the maximum number of events that are kept. The oldest events
+	// are discarded first. 0 means unlimited.
+	MaxEvents int
+}
+
+// SSEFeed opens an EventSource when it mounts and renders the events it receives
+// using renderItem. A status indicator is rendered before the events, and when the
+// connection is closed a button is rendered that reconnects when clicked.
+//
+// The elements have the classes "sse-feed", "sse-status" and "sse-reconnect" for styling.
+// The status indicator also has a data-status attribute containing the ConnectionStatus.
+//
+// Example:
+//
+//  react.SSEFeed(react.SSEProps{URL: "/api/feed", MaxEvents: 50}, func(e react.MessageEvent) interface{} {
+//      return elements.P(nil, e.Data)
+//  })
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/Server-sent_events
+func SSEFeed(props SSEProps, renderItem func(event MessageEvent) interface{}) interface{} {
+	if IsNative() {
+		// There is no EventSource
+		return nil
+	}
+	args := &sseFeedArgs{props: props, renderItem: renderItem}
+	return JSX(sseFeedComponent, map[string]interface{}{"args": js.MakeWrapper(args)})
+}
+
+type sseFeedArgs struct {
+	props      SSEProps
+	renderItem func(event MessageEvent) interface{}
+}
+
+type sseFeedState struct {
+	events  []MessageEvent
+	keys    []int
+	nextKey int
+	status  ConnectionStatus
+	attempt int
+	version int
+}
+
+// sseFeedComponent is the functional component used by SSEFeed.
+var sseFeedComponent = NamedComponent("SSEFeed", func(this *js.Object, arguments []*js.Object) interface{} {
+	args := arguments[0].Get("args").Interface().(*sseFeedArgs)
+	props := args.props
+
+	st := useGoRef(func() interface{} {
+		return &sseFeedState{status: ConnectionConnecting}
+	}).(*sseFeedState)
+
+	_, setVersion := useState(0)
+	rerender := func() {
+		st.version++
+		setVersion(st.version)
+	}
+
+	useEffect(func() func() {
+		st.status = ConnectionConnecting
+
+		es := js.Global.Get("EventSource").New(props.URL, js.M{"withCredentials": props.WithCredentials})
+
+		es.Set("onopen", func(e *js.Object) {
+			st.status = ConnectionOpen
+			rerender()
+		})
+
+		es.Set("onerror", func(e *js.Object) {
+			// The browser reconnects automatically unless the connection is closed
+			if es.Get("readyState").Int() == 2 {
+				st.status = ConnectionClosed
+			} else {
+				st.status = ConnectionConnecting
+			}
+			rerender()
+		})
+
+		onMessage := func(e *js.Object) {
+			st.events = append(st.events, MessageEvent{
+				O:           e,
+				Type:        e.Get("type").String(),
+				Data:        e.Get("data").String(),
+				LastEventID: e.Get("lastEventId").String(),
+				Origin:      e.Get("origin").String(),
+			})
+			st.keys = append(st.keys, st.nextKey)
+			st.nextKey++
+
+			if props.MaxEvents > 0 && len(st.events) > props.MaxEvents {
+				st.events = st.events[len(st.events)-props.MaxEvents:]
+				st.keys = st.keys[len(st.keys)-props.MaxEvents:]
+			}
+			rerender()
+		}
+
+		if len(props.EventTypes) == 0 {
+			es.Call("addEventListener", "message", onMessage)
+		}
+		for _, typ := range props.EventTypes {
+			es.Call("addEventListener", typ, onMessage)
+		}
+
+		return func() {
+			es.Call("close")
+		}
+	}, []interface{}{props.URL, props.WithCredentials, strings.Join(props.EventTypes, ","), st.attempt})
+
+	children := []interface{}{
+		JSX("span", map[string]interface{}{"className": "sse-status", "data-status": string(st.status)}, string(st.status)),
+	}
+
+	if st.status == ConnectionClosed {
+		children = append(children, JSX("button", map[string]interface{}{
+			"type":      "button",
+			"className": "sse-reconnect",
+			"onClick": func(e *js.Object) {
+				st.attempt++
+				rerender()
+			},
+		}, "Reconnect"))
+	}
+
+	for i, event := range st.events {
+		children = append(children, JSX(React.Get("Fragment"), map[string]interface{}{"key": strconv.Itoa(st.keys[i])}, args.renderItem(event)))
+	}
+
+	return JSX("div", map[string]interface{}{"className": "sse-feed"}, children...)
+})
@@ -0,0 +1,79 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// StoreSelector returns the value found at path in a global store.
+// It must return nil if path does not exist.
+type StoreSelector func(path string) interface{}
+
+// storeSelector is set by RegisterStoreSelector.
+var storeSelector StoreSelector
+
+// RegisterStoreSelector sets the StoreSelector used by the "selector" tag option.
+// When a struct is converted by SToMap, fields with the option are populated with
+// the value found at the path instead of the field's own value.
+//
+// If the path does not exist or the value can't be converted to the field's type,
+// the field's zero value is used. When Development is true, a warning is printed to the console
+// (once per path). Numbers are not converted to strings.
+//
+// Example:
+//
+//  react.RegisterStoreSelector(func(path string) interface{} {
+//      return store.Get(path)
+//  })
+//
+//  type Props struct {
+//      Name string `react:"name,selector=user.name"`
+//  }
+//
+func RegisterStoreSelector(fn StoreSelector) {
+	storeSelector = fn
+}
+
+// selectValue returns the value found at path in the store, converted to type t.
+func selectValue(path string, t reflect.Type) reflect.Value {
+
+	var v interface{}
+	if storeSelector != nil {
+		v = storeSelector(path)
+	}
+
+	if o, ok := v.(*js.Object); ok && t != reflect.TypeOf(o) {
+		if o == nil || o == js.Undefined {
+			v = nil
+		} else {
+			v = o.Interface()
+		}
+	}
+
+	if v == nil {
+		warnOnce("react: store selector path not found: " + path)
+		return reflect.Zero(t)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type().AssignableTo(t):
+		out := reflect.New(t).Elem()
+		out.Set(rv)
+		return out
+	case rv.Type().ConvertibleTo(t) && !(isNumeric(rv.Kind()) && t.Kind() == reflect.String):
+		// A number is not converted to a string (ie. 65 is not "A")
+		return rv.Convert(t)
+	}
+
+	warnOnce("react: store selector value at " + path + " can't be converted to " + t.String())
+	return reflect.Zero(t)
+}
+
+// isNumeric returns true if k is an integer or floating point kind.
+func isNumeric(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
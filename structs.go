@@ -3,9 +3,12 @@
 package react
 
 import (
+	"encoding"
 	"errors"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gopherjs/gopherjs/js"
 	"github.com/rocketlaunchr/react/forks/mapstructure"
@@ -13,35 +16,116 @@ import (
 
 // SToMap will convert a struct or pass-through a map.
 // If the argument is a struct, it will convert it to a map.
-// If the argument is a map, it will pass it through.
-// If the argument is nil, it will return nil.
-func SToMap(s interface{}) map[string]interface{} {
+// If the argument is a map, it will pass it through (after converting
+// ClassName and Style values).
+// If the argument is a js.S or []*js.Object, it will return a map keyed by
+// the indexes (the elements are passed through).
+// If the argument is nil (or a nil map), it will return nil.
+//
+// The conversion of structs can be controlled using opts.
+//
+// SToMap panics if s can't be converted. See SToMapE.
+func SToMap(s interface{}, opts ...Option) map[string]interface{} {
+	mp, err := SToMapE(s, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return mp
+}
+
+// ConversionError is returned when a value can't be converted by SToMapE.
+type ConversionError struct {
+	Kind reflect.Kind
+
+	// Path is the Go field path of the value (eg. "Header.Size"). It is empty
+	// if the argument itself can't be converted.
+	Path string
+
+	Msg string
+}
+
+// Error implements the error interface.
+func (e *ConversionError) Error() string {
+	if e.Path == "" {
+		return "react: " + e.Msg
+	}
+	return "react: " + e.Path + ": " + e.Msg
+}
+
+// SToMapE is the same as SToMap except that a *ConversionError is returned
+// instead of panicking when s (or one of its fields) can't be converted.
+func SToMapE(s interface{}, opts ...Option) (_ map[string]interface{}, rErr error) {
 
 	if s == nil {
-		return nil
+		return nil, nil
 	}
 
 	if jsObjectIsNil(s) {
-		return nil
+		return nil, nil
 	}
 
 	// Check if s is a struct
 	if isStruct(s) {
-		return convertStruct(s)
+		defer func() {
+			if r := recover(); r != nil {
+				cErr, ok := r.(*ConversionError)
+				if !ok {
+					panic(r)
+				}
+				rErr = cErr
+			}
+		}()
+		return convertStruct(s, newOptions(opts)), nil
 	}
 
+	// A nil map returns nil, but a non-nil empty map returns a non-nil empty map
+	// since React distinguishes between null and {} props.
 	switch x := s.(type) {
 	case js.M:
-		return map[string]interface{}(x)
+		if x == nil {
+			return nil, nil
+		}
+		return convertMapValues(x), nil
 	case map[string]interface{}:
-		return x
+		if x == nil {
+			return nil, nil
+		}
+		return convertMapValues(x), nil
+	case js.S:
+		return indexedMap(x), nil
+	case []*js.Object:
+		if x == nil {
+			return nil, nil
+		}
+		slc := make([]interface{}, len(x))
+		for i := range x {
+			slc[i] = x[i]
+		}
+		return indexedMap(slc), nil
 	default:
 		s := reflect.ValueOf(x)
-		if s.IsNil() {
-			return nil
+		switch s.Kind() {
+		case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+			if s.IsNil() {
+				return nil, nil
+			}
 		}
-		panic("unrecognized type")
+		return nil, &ConversionError{Kind: s.Kind(), Msg: "unrecognized type " + s.Type().String()}
+	}
+}
+
+// indexedMap returns a map keyed by the indexes of slc (the same as {...slc} in javascript).
+// The elements are passed through as is.
+func indexedMap(slc []interface{}) map[string]interface{} {
+	if slc == nil {
+		return nil
 	}
+
+	out := make(map[string]interface{}, len(slc))
+	for i, v := range slc {
+		out[strconv.Itoa(i)] = v
+	}
+	return out
 }
 
 // jsObjectIsNotNil returns true if x is a js object
@@ -63,56 +147,398 @@ func jsObjectIsNil(x interface{}) bool {
 }
 
 // convertStruct will convert a struct into a map.
-func convertStruct(sIn interface{}) map[string]interface{} {
+func convertStruct(sIn interface{}, opts *options) map[string]interface{} {
 
 	out := map[string]interface{}{}
 
-	s := reflect.ValueOf(sIn)
+	s, isNil := indirect(reflect.ValueOf(sIn))
+	if isNil {
+		return nil
+	}
+
+	convertFields(s, out, opts)
+	return out
+}
+
+// nested returns the options used to convert the nested struct at key of out.
+func (o *options) nested(key string, out map[string]interface{}) *options {
+	n := *o
+	n.setPath = o.setPath + key + "."
+	if n.setRoot == nil {
+		n.setRoot = out
+	}
+	return &n
+}
+
+// element returns the options used to convert the elements of a slice or map.
+// The Sets of an element are relative to the element.
+func (o *options) element() *options {
+	if o.setRoot == nil {
+		return o
+	}
+	n := *o
+	n.setPath, n.setRoot = "", nil
+	return &n
+}
+
+// convertSlice converts the elements of a slice or array. Structs and maps with string keys
+// are converted, while javascript objects (eg. React elements) and primitives are kept as is.
+func convertSlice(v reflect.Value, opts *options) []interface{} {
+	slc := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		slc = append(slc, convertElement(v.Index(i), opts))
+	}
+	return slc
+}
+
+// convertElement converts an element of a slice or array.
+func convertElement(e reflect.Value, opts *options) interface{} {
+
+	if e.Kind() == reflect.Interface {
+		if e.IsNil() {
+			return nil
+		}
+		e = e.Elem()
+	}
 
-	// Check if s is a pointer
-	if s.Kind() == reflect.Ptr {
-		s = reflect.Indirect(s)
+	val := e.Interface()
+	if jsObjectIsNotNil(val) {
+		return val
 	}
-	typeOfT := s.Type()
 
-	for i := 0; i < s.NumField(); i++ {
-		f := typeOfT.Field(i)
+	v, isNil := indirect(e)
+	if isNil {
+		return nil
+	}
+
+	if t, ok := timeValue(val); ok {
+		return t
+	}
+
+	if val, ok := marshalerValue(e); ok {
+		return val
+	}
+
+	if conv, ok := convertClassStyle(v.Interface()); ok {
+		return conv
+	}
 
-		if f.PkgPath != "" {
-			// not exported
+	switch v.Kind() {
+	case reflect.Struct:
+		return convertStruct(v.Interface(), opts)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		return convertSlice(v, opts)
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return val
+		}
+		mp := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			mp[iter.Key().String()] = convertElement(iter.Value(), opts)
+		}
+		return mp
+	}
+	return val
+}
+
+// jsonMarshaler is the same as json.Marshaler. encoding/json is not imported
+// since it significantly increases the size of the generated javascript.
+type jsonMarshaler interface {
+	MarshalJSON() ([]byte, error)
+}
+
+func isMarshaler(v interface{}) bool {
+	switch v.(type) {
+	case encoding.TextMarshaler, jsonMarshaler:
+		return true
+	}
+	return false
+}
+
+// marshalerValue returns the value of v if it implements encoding.TextMarshaler (the text is used)
+// or json.Marshaler (the json is parsed). A nil pointer is not marshaled. If marshaling fails,
+// a *ConversionError is panicked.
+func marshalerValue(v reflect.Value) (_ interface{}, ok bool) {
+
+	if _, isNil := indirect(v); isNil {
+		return nil, false
+	}
+
+	m := v.Interface()
+	if !isMarshaler(m) && v.CanAddr() {
+		// Pointer receiver
+		m = v.Addr().Interface()
+	}
+
+	switch x := m.(type) {
+	case encoding.TextMarshaler:
+		text, err := x.MarshalText()
+		if err != nil {
+			panic(&ConversionError{Kind: v.Kind(), Msg: "MarshalText: " + err.Error()})
+		}
+		return string(text), true
+	case jsonMarshaler:
+		b, err := x.MarshalJSON()
+		if err != nil {
+			panic(&ConversionError{Kind: v.Kind(), Msg: "MarshalJSON: " + err.Error()})
+		}
+		obj, err := JSONUnmarshal(string(b))
+		if err != nil {
+			panic(&ConversionError{Kind: v.Kind(), Msg: "MarshalJSON: " + err.Error()})
+		}
+		return obj, true
+	}
+
+	return nil, false
+}
+
+// indirect fully dereferences a chain of pointers (eg. **Inner).
+// isNil is true if any of the pointers are nil.
+func indirect(v reflect.Value) (_ reflect.Value, isNil bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return v, true
+		}
+		v = v.Elem()
+	}
+	return v, false
+}
+
+// convertFields will convert the fields of the struct s and store
+// them in out.
+//
+// The fields of embedded structs are promoted to out, provided the embedded
+// field has no name in its tag. Just like the standard library's json package,
+// fields of the outer struct take precedence over promoted fields.
+// Unexported embedded structs are also promoted (only their exported fields are used).
+// A nil embedded pointer promotes the zero values of its fields.
+func convertFields(s reflect.Value, out map[string]interface{}, opts *options) {
+
+	// field is the name of the field being converted.
+	// It is added to the path of a *ConversionError.
+	var field string
+	defer func() {
+		if r := recover(); r != nil {
+			if cErr, ok := r.(*ConversionError); ok && field != "" {
+				if cErr.Path == "" {
+					cErr.Path = field
+				} else {
+					cErr.Path = field + "." + cErr.Path
+				}
+			}
+			panic(r)
+		}
+	}()
+
+	promoted := map[string]interface{}{}
+
+	type role struct {
+		role     string
+		clickKey string
+	}
+	roles := []role{}
+	classes := ""
+
+	type autofocus struct {
+		focus     bool
+		mountOnly bool
+	}
+	var focus *autofocus
+	var hotkeys []hotkeyBinding
+	var copyText *string
+	var keyVal reflect.Value
+
+	type track struct {
+		key   string
+		event string
+	}
+	tracks := []track{}
+
+	for _, fi := range cachedFields(s.Type(), opts) {
+
+		fieldValRaw := s.Field(fi.index)
+		field = fi.name
+
+		tagName, tagOpts := fi.tagName, fi.tagOpts
+		omitEmpty := opts.omitEmpty(fi.omitEmpty)
+		key := fi.key
+
+		// Deal with embedded structs as a special case
+		if fi.embedded {
+			embedded := fieldValRaw
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					// The promoted fields have their zero values
+					embedded = reflect.Zero(embedded.Type().Elem())
+				} else {
+					embedded = embedded.Elem()
+				}
+			}
+
+			convertFields(embedded, promoted, opts)
 			continue
 		}
 
-		fieldName := typeOfT.Field(i).Name
-		fieldTag := f.Tag.Get("react")
-		fieldValRaw := s.Field(i)
+		// Populate the field from the store
+		if fi.selector != "" {
+			fieldValRaw = selectValue(fi.selector, fieldValRaw.Type())
+		}
+
 		fieldVal := fieldValRaw.Interface()
 
-		if fieldTag == "-" || (!jsObjectIsNotNil(fieldVal) && strings.HasSuffix(fieldTag, ",omitempty") && (fieldVal == nil || jsObjectIsNil(fieldVal) || reflect.DeepEqual(fieldVal, reflect.Zero(reflect.TypeOf(fieldVal)).Interface()))) {
+		// omitempty applies before the omit func
+		omitted := fi.skip || (!jsObjectIsNotNil(fieldVal) && omitEmpty && (fieldVal == nil || jsObjectIsNil(fieldVal) || reflect.DeepEqual(fieldVal, reflect.Zero(reflect.TypeOf(fieldVal)).Interface())))
+		if !omitted && opts.omitFunc != nil && opts.omitFunc(fi.name, fieldVal) {
+			continue
+		}
+
+		// Deal with variants as a special case
+		if fi.variant != "" {
+			classes = appendClasses(classes, variantClass(fi.variant, fieldValRaw))
+			continue
+		}
+
+		// Loading fields are used by ElementFromStruct
+		if fi.loading {
+			continue
+		}
+
+		// Deal with hotkeys as a special case
+		if fi.hotkey != "" {
+			if b := hotkeyBindingFor(fi.hotkey, fieldValRaw, tagOpts.has("preventdefault")); b != nil {
+				hotkeys = append(hotkeys, *b)
+			}
+			continue
+		}
+
+		// Deal with copy-to-clipboard text as a special case
+		if fi.copyText {
+			if text, ok := copyTextValue(fieldValRaw); ok {
+				copyText = &text
+			}
+			continue
+		}
+
+		// Deal with autofocus as a special case
+		if fi.autofocus != "" {
+			focus = &autofocus{autofocusValue(fieldValRaw), fi.autofocus == "mount"}
+			continue
+		}
+
+		if fi.isKey {
+			keyVal = fieldValRaw
+		}
+
+		if omitted {
 			// Omit field
 			continue
 		}
 
+		// Deal with inline fields as a special case
+		if fi.inline {
+			for attr, val := range inlineValue(fieldValRaw, opts) {
+				out[attr] = val
+			}
+			continue
+		}
+
+		if fi.role != "" {
+			roles = append(roles, role{fi.role, key})
+		}
+
+		if fi.track != "" {
+			tracks = append(tracks, track{key, fi.track})
+		}
+
+		// Deal with controlled inputs as a special case
+		if ci, ok := fieldVal.(ControlledInput); ok {
+			for attr, val := range ci.props() {
+				out[attr] = val
+			}
+			continue
+		}
+
+		// Deal with sanitized html as a special case
+		if fi.sanitize {
+			if mp, ok := sanitizeHTML(fieldValRaw); ok {
+				out["dangerouslySetInnerHTML"] = mp["dangerouslySetInnerHTML"]
+			}
+			continue
+		}
+
+		// Deal with media queries as a special case
+		if fi.media {
+			if val, ok := mediaValue(fieldValRaw); ok {
+				out[key] = val
+			}
+			continue
+		}
+
+		// Deal with functions returning promises as a special case
+		if fi.promise && fieldValRaw.Kind() == reflect.Func {
+			if fieldValRaw.IsNil() {
+				out[key] = nil
+			} else {
+				out[key] = Promisify(fieldVal)
+			}
+			continue
+		}
+
+		// Deal with class names and styles as a special case
+		if conv, ok := convertClassStyle(fieldVal); ok {
+			out[key] = conv
+			continue
+		}
+
+		// Deal with event handlers as a special case
+		if fn, ok := fieldVal.(func(*SyntheticEvent)); ok && fn != nil {
+			out[key] = func(e *js.Object) {
+				fn(&SyntheticEvent{O: e})
+			}
+			continue
+		}
+
+		// Deal with refs as a special case
+		if ref, ok := fieldVal.(refObject); ok {
+			out[key] = ref.refObject()
+			continue
+		}
+
 		// Deal with Sets as a special case
 		if set, ok := fieldVal.(Set); ok {
-			base := strings.TrimSuffix(fieldTag, ",omitempty")
-			if strings.TrimSpace(base) == "" {
+			if set.Len() == 0 && omitEmpty {
 				// Skip this Set
 				continue
 			}
 
-			all := set.Convert(base)
-			for attr, val := range all {
-				out[attr] = val
+			if tagName == "" {
+				// Skip this Set
+				continue
+			}
+
+			// The attributes of a Set inside a nested struct are prefixed with
+			// the path of the nested struct and added to the top-level map
+			dst := out
+			if opts.setRoot != nil {
+				dst = opts.setRoot
+			}
+			for attr, val := range set.Convert(opts.setPath + strings.TrimSpace(tagName)) {
+				dst[attr] = val
 			}
 			continue
 		}
 
 		// Deal with dangerouslySetInnerHTML as a special case
-		if fieldName == "DangerouslySetInnerHTML" && strings.TrimSuffix(fieldTag, ",omitempty") == "dangerouslySetInnerHTML" {
+		if fi.dangerouslySetInnerHTML {
 			if fn, ok := fieldVal.(func() interface{}); ok {
 				mp := DangerouslySetInnerHTMLFunc(fn)
 				out["dangerouslySetInnerHTML"] = mp["dangerouslySetInnerHTML"]
+			} else if once, ok := fieldVal.(*InnerHTMLOnce); ok && once != nil {
+				out["dangerouslySetInnerHTML"] = once.Map()["dangerouslySetInnerHTML"]
 			} else {
 				mp := DangerouslySetInnerHTML(fieldVal)
 				out["dangerouslySetInnerHTML"] = mp["dangerouslySetInnerHTML"]
@@ -120,42 +546,157 @@ func convertStruct(sIn interface{}) map[string]interface{} {
 			continue
 		}
 
-		// Deal with slices as a special case
-		if fieldValRaw.Kind() == reflect.Slice {
-			slc := []interface{}{}
-			for i := 0; i < fieldValRaw.Len(); i++ {
-				e := fieldValRaw.Index(i)
-				slc = append(slc, convertStruct(e.Interface()))
+		// Deal with time as a special case
+		if t, ok := timeValue(fieldVal); ok {
+			if tagOpts.has("relativetime") {
+				out[key] = RelativeTimeFunc(t)
+				continue
+			} else if tagOpts.has("rfc3339") {
+				out[key] = t.Format(time.RFC3339)
+				continue
 			}
 
-			if fieldTag == "" {
-				out[fieldName] = slc
+			// time.Time has no exported fields, so it is kept as is
+			// (so that UnmarshalStruct can read it back)
+			out[key] = t
+			continue
+		}
+
+		// Deal with types that marshal themselves as a special case
+		if val, ok := marshalerValue(fieldValRaw); ok {
+			out[key] = val
+			continue
+		}
+
+		// Deal with maps with string keys (eg. map[string]string) as a special case
+		if v, isNil := indirect(fieldValRaw); !isNil && v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String {
+			if v.IsNil() {
+				out[key] = nil
 			} else {
-				out[strings.TrimSuffix(fieldTag, ",omitempty")] = slc
+				out[key] = convertElement(v, opts.element())
 			}
 			continue
 		}
 
-		if fieldTag == "" {
-			if jsObjectIsNotNil(fieldVal) {
-				out[fieldName] = fieldVal
-			} else if isStruct(fieldVal) {
-				out[fieldName] = convertStruct(fieldVal)
-			} else {
-				out[fieldName] = fieldVal
+		// Deal with slices as a special case
+		if fieldValRaw.Kind() == reflect.Slice || fieldValRaw.Kind() == reflect.Array {
+			if fieldValRaw.Kind() == reflect.Slice && fieldValRaw.IsNil() {
+				// A nil slice stays distinct from an empty slice
+				out[key] = nil
+				continue
+			}
+
+			// Native values are passed through as is
+			switch fieldVal.(type) {
+			case js.S, []*js.Object:
+				out[key] = fieldVal
+			default:
+				out[key] = convertSlice(fieldValRaw, opts.element())
 			}
+			continue
+		}
+
+		if jsObjectIsNotNil(fieldVal) {
+			out[key] = fieldVal
+		} else if v, isNil := indirect(fieldValRaw); isNil {
+			// A nil pointer becomes null
+			out[key] = nil
+		} else if v.Kind() == reflect.Struct {
+			out[key] = convertStruct(v.Interface(), opts.nested(key, out))
 		} else {
-			if jsObjectIsNotNil(fieldVal) {
-				out[strings.TrimSuffix(fieldTag, ",omitempty")] = fieldVal
-			} else if isStruct(fieldVal) {
-				out[strings.TrimSuffix(fieldTag, ",omitempty")] = convertStruct(fieldVal)
-			} else {
-				out[strings.TrimSuffix(fieldTag, ",omitempty")] = fieldVal
+			out[key] = fieldVal
+		}
+	}
+
+	for attr, val := range promoted {
+		if _, exists := out[attr]; !exists {
+			out[attr] = val
+		}
+	}
+
+	if classes != "" {
+		className, _ := out["className"].(string)
+		out["className"] = appendClasses(className, classes)
+	}
+
+	if keyVal.IsValid() && !keyVal.IsZero() {
+		if _, exists := out["key"]; !exists {
+			if k, ok := keyString(keyVal); ok {
+				out["key"] = k
 			}
 		}
 	}
 
-	return out
+	for _, t := range tracks {
+		applyTracking(out, t.key, t.event)
+	}
+
+	if copyText != nil {
+		applyCopyText(out, *copyText)
+	}
+
+	for _, r := range roles {
+		applyRole(out, r.role, r.clickKey)
+	}
+
+	if len(hotkeys) > 0 {
+		applyHotkeys(out, hotkeys)
+	}
+
+	if focus != nil {
+		applyAutofocus(out, focus.focus, focus.mountOnly)
+	}
+}
+
+// inlineValue returns the keys of a map or struct field that has
+// the "inline" tag option. The keys are merged into the parent map (like
+// prop spreading in JSX), with later fields overriding earlier fields.
+//
+// Example:
+//
+//  type Props struct {
+//      ID    string `react:"id"`
+//      Extra js.M   `react:",inline"`
+//  }
+//
+func inlineValue(v reflect.Value, opts *options) map[string]interface{} {
+
+	if o, ok := v.Interface().(*js.Object); ok {
+		if o == nil || o == js.Undefined {
+			return nil
+		}
+		out := map[string]interface{}{}
+		keys := js.Global.Get("Object").Call("keys", o)
+		for i := 0; i < keys.Length(); i++ {
+			k := keys.Index(i).String()
+			out[k] = o.Get(k)
+		}
+		return out
+	}
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return convertStruct(v.Interface(), opts)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			panic(&ConversionError{Kind: v.Kind(), Msg: "inline map must have string keys"})
+		}
+		out := map[string]interface{}{}
+		iter := v.MapRange()
+		for iter.Next() {
+			out[iter.Key().String()] = iter.Value().Interface()
+		}
+		return out
+	default:
+		panic(&ConversionError{Kind: v.Kind(), Msg: "inline field must be a map or struct"})
+	}
 }
 
 // isStruct returns true if s is a struct.
@@ -174,36 +715,137 @@ func isStruct(s interface{}) bool {
 }
 
 // UnmarshalStruct will unmarshal a struct with values from a map.
-// strct must be a pointer to a struct. Use struct tag "react" for linking
-// map keys to the struct's fields.
+// strct must be a pointer to a struct (otherwise a *ConversionError is returned).
+// Use struct tag "react" for linking map keys to the struct's fields.
+//
+// If a field has the "required" tag option and its key is missing from the map
+// (or is null or undefined), a *MissingPropsError listing every missing field is returned.
+//
+// If a field has a "default" tag (eg. `react:"size" default:"medium"`) and its key is
+// missing from the map, the field is set to the default. An explicit zero value (or null)
+// is respected. Since SToMap omits zero values of fields with the "omitempty" tag option,
+// those fields become the default when they are read back.
+//
+// Values decoded into a type registered with RegisterEnum must be one of its valid values.
+//
+// UnmarshalStruct reverses SToMap: embedded structs, "inline" (or "squash") structs, nested structs,
+// slices of structs and time.Time fields are read back. Sets, dangerouslySetInnerHTML
+// and fields with special tag options (eg. "role" and "variant") can't be reversed.
 func UnmarshalStruct(mp map[string]interface{}, strct interface{}) error {
+	return unmarshalStruct(mp, strct, false)
+}
+
+// UnmarshalStructStrict is the same as UnmarshalStruct except that an error
+// is returned listing all map keys that don't correspond to a field in strct.
+// It is recommended for new components since typos in key names are otherwise
+// silently ignored.
+func UnmarshalStructStrict(mp map[string]interface{}, strct interface{}) error {
+	return unmarshalStruct(mp, strct, true)
+}
+
+func unmarshalStruct(mp map[string]interface{}, strct interface{}, strict bool) error {
+
+	if err := decodeStruct(mp, strct, strict); err != nil {
+		return err
+	}
+	if err := applyDefaults(mp, strct); err != nil {
+		return err
+	}
+	return checkRequired(mp, strct)
+}
+
+// decodeStruct decodes mp into strct (without applying defaults or checking required fields).
+func decodeStruct(mp map[string]interface{}, strct interface{}, strict bool) error {
 
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		ZeroFields: true,
-		TagName:    "react",
-		Result:     strct,
+		ErrorUnused: strict,
+		ZeroFields:  true,
+		TagName:     "react",
+		FieldKey:    reactFieldKey,
+		DecodeHook:  enumDecodeHook,
+		Result:      strct,
 	})
 	if err != nil {
-		panic(err)
+		// strct is not a pointer
+		return &ConversionError{Kind: reflect.ValueOf(strct).Kind(), Msg: err.Error()}
 	}
 
 	return decoder.Decode(mp)
 }
 
+// objectMap returns the properties of o, which is the props or state (named name)
+// of a component. undefined and null are treated as an empty object.
+// A *ConversionError is returned if o is not an object (eg. a number or string).
+func objectMap(o *js.Object, name string) (map[string]interface{}, error) {
+
+	if o == nil || o == js.Undefined {
+		return map[string]interface{}{}, nil
+	}
+
+	val := o.Interface()
+	switch x := val.(type) {
+	case map[string]interface{}:
+		return x, nil
+	case string, float64, bool:
+		return nil, &ConversionError{Kind: reflect.TypeOf(val).Kind(), Msg: name + " is not an object"}
+	}
+
+	// Iterate over the object's own enumerable properties
+	mp := map[string]interface{}{}
+	keys := js.Global.Get("Object").Call("keys", o)
+	for i := 0; i < keys.Length(); i++ {
+		key := keys.Index(i).String()
+		mp[key] = o.Get(key).Interface()
+	}
+	return mp, nil
+}
+
 // UnmarshalProps will unmarshal a given struct with values from
 // the component's prop. strct must be a pointer to a struct.
+// If the component has no props, strct is left unchanged.
 func UnmarshalProps(this *js.Object, strct interface{}) error {
-	props := this.Get("props").Interface().(map[string]interface{})
+	props, err := objectMap(this.Get("props"), "props")
+	if err != nil {
+		return err
+	}
 	return UnmarshalStruct(props, strct)
 }
 
+// UnmarshalPropsStrict is the same as UnmarshalProps except that
+// an error is returned if a prop doesn't correspond to a field in strct.
+//
+// See: UnmarshalStructStrict
+func UnmarshalPropsStrict(this *js.Object, strct interface{}) error {
+	props, err := objectMap(this.Get("props"), "props")
+	if err != nil {
+		return err
+	}
+	return UnmarshalStructStrict(props, strct)
+}
+
 // UnmarshalState will unmarshal a given struct with values from
 // the component's state. strct must be a pointer to a struct.
+// If the component has no state, strct is left unchanged.
 func UnmarshalState(this *js.Object, strct interface{}) error {
-	state := this.Get("state").Interface().(map[string]interface{})
+	state, err := objectMap(this.Get("state"), "state")
+	if err != nil {
+		return err
+	}
 	return UnmarshalStruct(state, strct)
 }
 
+// UnmarshalStateStrict is the same as UnmarshalState except that
+// an error is returned if a state key doesn't correspond to a field in strct.
+//
+// See: UnmarshalStructStrict
+func UnmarshalStateStrict(this *js.Object, strct interface{}) error {
+	state, err := objectMap(this.Get("state"), "state")
+	if err != nil {
+		return err
+	}
+	return UnmarshalStructStrict(state, strct)
+}
+
 // HydrateProps will hydrate a given struct with values from
 // the component's prop. strct must be a pointer to a struct.
 //
@@ -237,3 +879,129 @@ func JSONUnmarshal(json string) (*js.Object, error) {
 
 	return obj, nil
 }
+
+// JSONMarshal provides a simple way to marshal structs (and other values) into json
+// without importing encoding/json, which significantly increases the size of the generated
+// javascript. Structs are converted using SToMap, so the react struct tags are used.
+// Maps and slices are converted element-wise and *js.Object values are used directly.
+//
+// Just like encoding/json, map keys must be strings, integers or implement encoding.TextMarshaler.
+//
+// An error is returned if the native JSON.stringify function throws (eg. for circular structures)
+// or if v can't be converted (a *ConversionError).
+//
+// Example:
+//
+//  // Persist state to localStorage
+//  s, err := react.JSONMarshal(tState)
+//  if err == nil {
+//      js.Global.Get("localStorage").Call("setItem", "state", s)
+//  }
+//
+func JSONMarshal(v interface{}) (string, error) {
+	return jsonMarshal(v)
+}
+
+// JSONMarshalIndent is the same as JSONMarshal except that the output is indented
+// with indent (eg. "  ").
+func JSONMarshalIndent(v interface{}, indent string) (string, error) {
+	return jsonMarshal(v, nil, indent)
+}
+
+func jsonMarshal(v interface{}, args ...interface{}) (_ string, rErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			cErr, ok := r.(*ConversionError)
+			if !ok {
+				panic(r)
+			}
+			rErr = cErr
+		}
+	}()
+
+	out, err := JSFn("JSON.stringify", append([]interface{}{toJSONValue(v)}, args...)...)
+	if err != nil {
+		return "", err
+	}
+
+	if out == nil || out == js.Undefined {
+		return "", errors.New("JSONMarshal: value can't be represented as json")
+	}
+
+	return out.String(), nil
+}
+
+// jsonKey returns the json object key of the map key k.
+func jsonKey(k reflect.Value) string {
+
+	if k.Kind() == reflect.String {
+		return k.String()
+	}
+
+	if tm, ok := k.Interface().(encoding.TextMarshaler); ok {
+		if k.Kind() == reflect.Ptr && k.IsNil() {
+			return ""
+		}
+		b, err := tm.MarshalText()
+		if err != nil {
+			panic(&ConversionError{Kind: k.Kind(), Msg: "MarshalText: " + err.Error()})
+		}
+		return string(b)
+	}
+
+	switch k.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(k.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(k.Uint(), 10)
+	}
+
+	panic(&ConversionError{Kind: k.Kind(), Msg: "unsupported map key type " + k.Type().String()})
+}
+
+// toJSONValue prepares v so that it can be passed to JSON.stringify.
+func toJSONValue(v interface{}) interface{} {
+
+	if v == nil || jsObjectIsNotNil(v) || jsObjectIsNil(v) {
+		return v
+	}
+
+	if t, ok := timeValue(v); ok {
+		// Becomes a Date, which is stringified in ISO format
+		return t
+	}
+
+	if isStruct(v) {
+		return toJSONValue(SToMap(v))
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		out := map[string]interface{}{}
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[jsonKey(iter.Key())] = toJSONValue(iter.Value().Interface())
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return nil
+		}
+		out := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			out = append(out, toJSONValue(rv.Index(i).Interface()))
+		}
+		return out
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return toJSONValue(rv.Elem().Interface())
+	}
+
+	return v
+}
@@ -0,0 +1,89 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// UnmarshalStructAs is the same as UnmarshalStruct except that it allocates
+// and returns the struct. T must be a struct type (or a pointer to one),
+// otherwise a *ConversionError is returned.
+//
+// Example:
+//
+//  tProps, err := react.UnmarshalStructAs[TimerProps](mp)
+//
+func UnmarshalStructAs[T any](mp map[string]interface{}) (T, error) {
+	var strct T
+	if err := checkStructType[T]("UnmarshalStructAs"); err != nil {
+		return strct, err
+	}
+	err := UnmarshalStruct(mp, &strct)
+	return strct, err
+}
+
+// UnmarshalPropsAs is the same as UnmarshalProps except that it allocates
+// and returns the struct. T must be a struct type (or a pointer to one),
+// otherwise a *ConversionError is returned.
+//
+// Example:
+//
+//  tProps, err := react.UnmarshalPropsAs[TimerProps](this)
+//
+func UnmarshalPropsAs[T any](this *js.Object) (T, error) {
+	var strct T
+	if err := checkStructType[T]("UnmarshalPropsAs"); err != nil {
+		return strct, err
+	}
+	err := UnmarshalProps(this, &strct)
+	return strct, err
+}
+
+// UnmarshalStateAs is the same as UnmarshalState except that it allocates
+// and returns the struct. T must be a struct type (or a pointer to one),
+// otherwise a *ConversionError is returned.
+//
+// Example:
+//
+//  tState, err := react.UnmarshalStateAs[TimerState](this)
+//
+func UnmarshalStateAs[T any](this *js.Object) (T, error) {
+	var strct T
+	if err := checkStructType[T]("UnmarshalStateAs"); err != nil {
+		return strct, err
+	}
+	err := UnmarshalState(this, &strct)
+	return strct, err
+}
+
+// MapToStruct is an alias of UnmarshalStructAs.
+//
+// Example:
+//
+//  p, err := react.MapToStruct[ButtonProps](mp)
+//
+func MapToStruct[T any](mp map[string]interface{}) (T, error) {
+	return UnmarshalStructAs[T](mp)
+}
+
+// PropsAs is an alias of UnmarshalPropsAs.
+//
+// Example:
+//
+//  p, err := react.PropsAs[ButtonProps](this)
+//
+func PropsAs[T any](this *js.Object) (T, error) {
+	return UnmarshalPropsAs[T](this)
+}
+
+// checkStructType returns a *ConversionError if T is not a struct (or a pointer to a struct).
+func checkStructType[T any](name string) error {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if indirectType(t).Kind() != reflect.Struct {
+		return &ConversionError{Kind: t.Kind(), Msg: name + ": T must be a struct, not " + t.String()}
+	}
+	return nil
+}
@@ -0,0 +1,861 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test.
+
+type rtChild struct {
+	Name string `react:"name"`
+	Age  int    `react:"age,omitempty"`
+}
+
+type RTEmbedded struct {
+	ID string `react:"id"`
+}
+
+type rtProps struct {
+	RTEmbedded
+	Title     string  `react:"title,omitempty"`
+	Subtitle  string  `react:"subtitle,omitempty"`
+	Count     int     `react:"count"`
+	Ratio     float64 `react:"ratio"`
+	Enabled   bool    `react:"enabled"`
+	Untagged  string
+	OptsOnly  string     `react:",omitempty"`
+	Child     rtChild    `react:"child"`
+	PChild    *rtChild   `react:"pchild"`
+	NilChild  *rtChild   `react:"nilChild"`
+	Children  []rtChild  `react:"children"`
+	PChildren []*rtChild `react:"pchildren"`
+	NilSlice  []rtChild  `react:"nilSlice"`
+	Empty     []rtChild  `react:"empty"`
+	Inline    rtChild    `react:"inline,inline"`
+	Created   time.Time  `react:"created"`
+	Ignored   string     `react:"-"`
+}
+
+func TestSToMapRoundTrip(t *testing.T) {
+
+	tests := []struct {
+		name string
+		in   rtProps
+	}{
+		{"zero", rtProps{}},
+		{"full", rtProps{
+			RTEmbedded: RTEmbedded{ID: "abc"},
+			Title:      "title",
+			Count:      3,
+			Ratio:      0.5,
+			Enabled:    true,
+			Untagged:   "untagged",
+			OptsOnly:   "opts",
+			Child:      rtChild{Name: "child", Age: 7},
+			PChild:     &rtChild{Name: "pchild"},
+			Children:   []rtChild{{Name: "a", Age: 1}, {Name: "b"}},
+			PChildren:  []*rtChild{{Name: "c", Age: 2}},
+			Empty:      []rtChild{},
+			Inline:     rtChild{Name: "inline", Age: 9},
+			Created:    time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out rtProps
+			if err := UnmarshalStruct(SToMap(tc.in), &out); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(out, tc.in) {
+				t.Errorf("round trip mismatch:\n got: %#v\nwant: %#v", out, tc.in)
+			}
+		})
+	}
+}
+
+// The generic functions are checked at compile time. The result has the type of the
+// type parameter, so a wrong type parameter doesn't compile:
+//
+//  var p rtProps
+//  p, err = UnmarshalStructAs[rtChild](mp) // cannot use 1st function result (value of struct type rtChild) as rtProps value in multiple assignment
+//
+var (
+	_ func(map[string]interface{}) (rtProps, error) = UnmarshalStructAs[rtProps]
+	_ func(*js.Object) (rtProps, error)             = UnmarshalPropsAs[rtProps]
+	_ func(*js.Object) (*rtProps, error)            = UnmarshalStateAs[*rtProps]
+)
+
+func TestUnmarshalStructAsWrongType(t *testing.T) {
+
+	mp := map[string]interface{}{"name": "child", "age": 3}
+
+	if out, err := UnmarshalStructAs[*rtChild](mp); err != nil || out == nil || out.Name != "child" {
+		t.Errorf("unexpected result: %#v (%v)", out, err)
+	}
+
+	// A type parameter that isn't a struct returns an error (instead of panicking)
+	_, err := UnmarshalStructAs[int](mp)
+	cErr, ok := err.(*ConversionError)
+	if !ok {
+		t.Fatalf("expected a *ConversionError, got: %v", err)
+	}
+	if !strings.Contains(cErr.Error(), "UnmarshalStructAs") || !strings.Contains(cErr.Error(), "int") {
+		t.Errorf("expected the error to name the function and type, got: %s", cErr)
+	}
+}
+
+func TestSToMapNilAndEmptyMaps(t *testing.T) {
+
+	var nilObject *js.Object
+
+	tests := []struct {
+		name string
+		in   interface{}
+		want map[string]interface{} // nil means SToMap must return nil
+	}{
+		{"nil", nil, nil},
+		{"nil map", map[string]interface{}(nil), nil},
+		{"nil js.M", js.M(nil), nil},
+		{"nil *js.Object", nilObject, nil},
+		{"nil struct pointer", (*rtChild)(nil), nil},
+		{"empty map", map[string]interface{}{}, map[string]interface{}{}},
+		{"empty js.M", js.M{}, map[string]interface{}{}},
+		{"populated map", map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1}},
+		{"populated js.M", js.M{"a": 1, "b": "x"}, map[string]interface{}{"a": 1, "b": "x"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := SToMap(tc.in)
+			if (mp == nil) != (tc.want == nil) {
+				t.Fatalf("expected nil to be %v, got: %#v", tc.want == nil, mp)
+			}
+			if tc.want != nil && !reflect.DeepEqual(mp, tc.want) {
+				t.Errorf("expected %#v, got: %#v", tc.want, mp)
+			}
+		})
+	}
+}
+
+func TestSToMapOptionsOnlyTag(t *testing.T) {
+	mp := SToMap(rtProps{OptsOnly: "x"})
+
+	if mp["OptsOnly"] != "x" {
+		t.Errorf("expected key OptsOnly, got: %#v", mp)
+	}
+	if _, exists := mp[""]; exists {
+		t.Errorf("unexpected empty key: %#v", mp)
+	}
+}
+
+type RTTagEmbedded struct {
+	Tag string `react:"tag,omitempty"`
+}
+
+type rtLabel struct {
+	Label string `react:"label"`
+}
+
+type rtTagged struct {
+	*RTTagEmbedded `react:",omitempty"`
+	Value          rtChild  `react:"value,omitempty,squash"`
+	Dup            string   `react:"dup,omitempty,omitempty"`
+	Unknown        int      `react:"unknown,string,bogus"`
+	Spaces         bool     `react:" spaces , omitempty"`
+	NoName         float64  `react:",omitempty"`
+	PInline        *rtLabel `react:"pinline,inline"`
+	Skipped        string   `react:"-"`
+}
+
+func TestSToMapTagRoundTrip(t *testing.T) {
+
+	// Every combination of zero and non-zero fields must survive a round trip
+	for i := 0; i < 1<<7; i++ {
+		var in rtTagged
+		if i&1 != 0 {
+			in.RTTagEmbedded = &RTTagEmbedded{Tag: "tag"}
+		}
+		if i&2 != 0 {
+			in.Value = rtChild{Name: "value", Age: i}
+		}
+		if i&4 != 0 {
+			in.Dup = "dup"
+		}
+		if i&8 != 0 {
+			in.Unknown = i
+		}
+		if i&16 != 0 {
+			in.Spaces = true
+		}
+		if i&32 != 0 {
+			in.NoName = float64(i) / 2
+		}
+		if i&64 != 0 {
+			in.PInline = &rtLabel{Label: "pinline"}
+		}
+
+		mp := SToMap(in)
+		for _, key := range []string{"", "value", " spaces "} {
+			if _, exists := mp[key]; exists {
+				t.Fatalf("%d: unexpected key %q: %#v", i, key, mp)
+			}
+		}
+
+		var out rtTagged
+		if err := UnmarshalStruct(mp, &out); err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+
+		if !reflect.DeepEqual(out, in) {
+			t.Errorf("%d: round trip mismatch:\n got: %#v\nwant: %#v", i, out, in)
+		}
+	}
+}
+
+func TestSToMapInlinePrecedence(t *testing.T) {
+
+	// Later fields override earlier fields (like prop spreading in JSX)
+	type inlineLast struct {
+		Title string                 `react:"title"`
+		Extra map[string]interface{} `react:",inline"`
+	}
+
+	type inlineFirst struct {
+		Extra js.M   `react:",inline,omitempty"`
+		Title string `react:"title"`
+	}
+
+	type inlineStruct struct {
+		Name  string  `react:"name"`
+		Child rtChild `react:",inline"`
+		Age   int     `react:"age"`
+	}
+
+	tests := []struct {
+		name string
+		in   interface{}
+		want map[string]interface{}
+	}{
+		{"inline after named field", inlineLast{Title: "named", Extra: map[string]interface{}{"title": "inline", "id": "x"}},
+			map[string]interface{}{"title": "inline", "id": "x"}},
+		{"inline before named field", inlineFirst{Extra: js.M{"title": "inline", "id": "x"}, Title: "named"},
+			map[string]interface{}{"title": "named", "id": "x"}},
+		{"empty inline", inlineFirst{Extra: js.M{}, Title: "named"},
+			map[string]interface{}{"title": "named"}},
+		{"nil inline without omitempty", inlineLast{Title: "named"},
+			map[string]interface{}{"title": "named"}},
+		{"inline struct", inlineStruct{Name: "named", Child: rtChild{Name: "inline", Age: 7}, Age: 9},
+			map[string]interface{}{"name": "inline", "age": 9}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if mp := SToMap(tc.in); !reflect.DeepEqual(mp, tc.want) {
+				t.Errorf("expected %#v, got: %#v", tc.want, mp)
+			}
+		})
+	}
+}
+
+type RTInner struct {
+	Inner string `react:"inner"`
+}
+
+type RTMiddle struct {
+	RTInner
+	Middle string `react:"middle"`
+}
+
+type rtBase struct {
+	Base   string `react:"base"`
+	hidden string
+}
+
+type rtDeep struct {
+	RTMiddle
+	rtBase
+	*RTTagEmbedded
+	Outer string `react:"outer"`
+}
+
+func TestSToMapEmbedded(t *testing.T) {
+
+	tests := []struct {
+		name string
+		in   rtDeep
+		want map[string]interface{}
+	}{
+		{"nil pointer", rtDeep{
+			RTMiddle: RTMiddle{RTInner: RTInner{Inner: "inner"}, Middle: "middle"},
+			rtBase:   rtBase{Base: "base", hidden: "hidden"},
+			Outer:    "outer",
+		}, map[string]interface{}{"inner": "inner", "middle": "middle", "base": "base", "outer": "outer"}},
+		{"pointer", rtDeep{
+			RTMiddle:      RTMiddle{RTInner: RTInner{Inner: "inner"}, Middle: "middle"},
+			rtBase:        rtBase{Base: "base"},
+			RTTagEmbedded: &RTTagEmbedded{Tag: "tag"},
+			Outer:         "outer",
+		}, map[string]interface{}{"inner": "inner", "middle": "middle", "base": "base", "tag": "tag", "outer": "outer"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := SToMap(tc.in)
+
+			// Two levels of embedding, an unexported embedded struct and
+			// a pointer are all promoted (a nil pointer promotes zero values)
+			if !reflect.DeepEqual(mp, tc.want) {
+				t.Errorf("expected %#v, got: %#v", tc.want, mp)
+			}
+
+			var out rtDeep
+			if err := UnmarshalStruct(mp, &out); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tc.in.hidden = ""
+			if !reflect.DeepEqual(out, tc.in) {
+				t.Errorf("round trip mismatch:\n got: %#v\nwant: %#v", out, tc.in)
+			}
+		})
+	}
+}
+
+type RTZeroEmbedded struct {
+	Count int    `react:"count"`
+	Note  string `react:"note,omitempty"`
+}
+
+type rtZeroEmbedding struct {
+	*RTZeroEmbedded
+	Outer string `react:"outer"`
+}
+
+func TestSToMapNilEmbeddedPointer(t *testing.T) {
+
+	// The fields promoted from a nil embedded pointer have their zero values
+	want := map[string]interface{}{"count": 0, "outer": "outer"}
+
+	if mp := SToMap(rtZeroEmbedding{Outer: "outer"}); !reflect.DeepEqual(mp, want) {
+		t.Errorf("SToMap: expected %#v, got: %#v", want, mp)
+	}
+
+	v, err := SerializeCloneable(rtZeroEmbedding{Outer: "outer"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := map[string]interface{}{"count": int64(0), "outer": "outer"}; !reflect.DeepEqual(v, want) {
+		t.Errorf("SerializeCloneable: expected %#v, got: %#v", want, v)
+	}
+}
+
+type rtPointers struct {
+	Ptr       *RTInner  `react:"ptr"`
+	PtrPtr    **RTInner `react:"ptrPtr"`
+	Nil       *RTInner  `react:"nil"`
+	NilPtr    **RTInner `react:"nilPtr"`
+	OmitNil   *RTInner  `react:"omitNil,omitempty"`
+	OmitValue *RTInner  `react:"omitValue,omitempty"`
+}
+
+func TestSToMapPointers(t *testing.T) {
+
+	inner := &RTInner{Inner: "a"}
+	var nilInner *RTInner
+
+	t.Run("top level", func(t *testing.T) {
+		want := map[string]interface{}{"inner": "a"}
+
+		if mp := SToMap(inner); !reflect.DeepEqual(mp, want) {
+			t.Errorf("*Inner: expected %#v, got: %#v", want, mp)
+		}
+		if mp := SToMap(&inner); !reflect.DeepEqual(mp, want) {
+			t.Errorf("**Inner: expected %#v, got: %#v", want, mp)
+		}
+		if mp := SToMap(nilInner); mp != nil {
+			t.Errorf("nil *Inner: expected nil, got: %#v", mp)
+		}
+		if mp := SToMap(&nilInner); mp != nil {
+			t.Errorf("**Inner to nil: expected nil, got: %#v", mp)
+		}
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		mp := SToMap(rtPointers{
+			Ptr:       inner,
+			PtrPtr:    &inner,
+			NilPtr:    &nilInner,
+			OmitValue: inner,
+		})
+
+		want := map[string]interface{}{
+			"ptr":       map[string]interface{}{"inner": "a"},
+			"ptrPtr":    map[string]interface{}{"inner": "a"},
+			"nil":       nil,
+			"nilPtr":    nil,
+			"omitValue": map[string]interface{}{"inner": "a"},
+		}
+		if !reflect.DeepEqual(mp, want) {
+			t.Errorf("expected %#v, got: %#v", want, mp)
+		}
+
+		// A nil pointer is null, not undefined
+		for _, key := range []string{"nil", "nilPtr"} {
+			if v, exists := mp[key]; !exists || v != nil {
+				t.Errorf("expected %q to be present and nil, got: %#v", key, v)
+			}
+		}
+		if _, exists := mp["omitNil"]; exists {
+			t.Errorf("expected omitNil to be omitted")
+		}
+	})
+}
+
+type rtSetInner struct {
+	Colors Set    `react:"color-"`
+	Size   string `react:"size"`
+}
+
+type rtSetMiddle struct {
+	Inner rtSetInner `react:"inner"`
+}
+
+type rtSetProps struct {
+	Aria   Set `react:"aria-"`
+	Data   Set `react:"data-,omitempty"`
+	Own    Set `react:",omitempty"` // Untagged Sets are skipped
+	Plain  Set
+	Middle rtSetMiddle `react:"style"`
+}
+
+func TestSToMapSets(t *testing.T) {
+
+	tests := []struct {
+		name string
+		in   rtSetProps
+		want map[string]interface{}
+	}{
+		{"empty", rtSetProps{Aria: NewSet(), Data: NewSet(), Own: NewSet(), Plain: NewSet()}, map[string]interface{}{
+			"style": map[string]interface{}{"inner": map[string]interface{}{"size": ""}},
+		}},
+		{"populated", rtSetProps{
+			Aria:   NewSet("label", "Close"),
+			Data:   NewSet("id", "1"),
+			Own:    NewSet("a", "b"),
+			Plain:  NewSet("c", "d"),
+			Middle: rtSetMiddle{rtSetInner{Colors: NewSet("primary", "red"), Size: "large"}},
+		}, map[string]interface{}{
+			"aria-label": "Close",
+			"data-id":    "1",
+			"style":      map[string]interface{}{"inner": map[string]interface{}{"size": "large"}},
+
+			// A Set nested two levels deep is prefixed with the path of its struct
+			"style.inner.color-primary": "red",
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if mp := SToMap(tc.in); !reflect.DeepEqual(mp, tc.want) {
+				t.Errorf("expected %#v, got: %#v", tc.want, mp)
+			}
+		})
+	}
+}
+
+func TestSToMapSliceElements(t *testing.T) {
+
+	// Stand-ins for React elements
+	elem1 := js.Global.Get("Object").New()
+	elem2 := js.Global.Get("Object").New()
+
+	type props struct {
+		Children []*js.Object  `react:"children"`
+		Mixed    []interface{} `react:"mixed"`
+		Numbers  []int         `react:"numbers"`
+		Array    [2]string     `react:"array"`
+		Kids     []*rtChild    `react:"kids"`
+	}
+
+	mp := SToMap(props{
+		Children: []*js.Object{elem1, elem2},
+		Mixed:    []interface{}{"text", elem1, nil},
+		Numbers:  []int{1, 2, 3},
+		Array:    [2]string{"a", "b"},
+		Kids:     []*rtChild{{Name: "a"}, nil},
+	})
+
+	children := mp["children"].([]*js.Object)
+	if len(children) != 2 || children[0] != elem1 || children[1] != elem2 {
+		t.Errorf("elements were not passed through: %#v", children)
+	}
+
+	mixed := mp["mixed"].([]interface{})
+	if len(mixed) != 3 || mixed[0] != "text" || mixed[1] != elem1 || mixed[2] != nil {
+		t.Errorf("mixed children were not passed through: %#v", mixed)
+	}
+
+	if numbers := mp["numbers"]; !reflect.DeepEqual(numbers, []interface{}{1, 2, 3}) {
+		t.Errorf("numbers were converted: %#v", numbers)
+	}
+
+	if array := mp["array"]; !reflect.DeepEqual(array, []interface{}{"a", "b"}) {
+		t.Errorf("array was converted: %#v", array)
+	}
+
+	kids := mp["kids"].([]interface{})
+	if !reflect.DeepEqual(kids[0], map[string]interface{}{"name": "a"}) || kids[1] != nil {
+		t.Errorf("unexpected kids: %#v", kids)
+	}
+}
+
+func TestSToMapNativeSlices(t *testing.T) {
+
+	elem := js.Global.Get("Object").New()
+
+	type props struct {
+		S js.S `react:"s"`
+	}
+
+	s := js.S{"text", elem, 3}
+	mp := SToMap(props{S: s})
+	if got, ok := mp["s"].(js.S); !ok || len(got) != 3 || got[1] != elem {
+		t.Errorf("js.S field was not passed through: %#v", mp["s"])
+	}
+
+	if mp := SToMap(js.S{"a", elem}); len(mp) != 2 || mp["0"] != "a" || mp["1"] != elem {
+		t.Errorf("unexpected map for js.S: %#v", mp)
+	}
+
+	if mp := SToMap([]*js.Object{elem}); len(mp) != 1 || mp["0"] != elem {
+		t.Errorf("unexpected map for []*js.Object: %#v", mp)
+	}
+
+	if mp := SToMap(js.S(nil)); mp != nil {
+		t.Errorf("expected nil for a nil js.S, got: %#v", mp)
+	}
+}
+
+func TestUnmarshalPropsNotMap(t *testing.T) {
+
+	type props struct {
+		Name string `react:"name"`
+	}
+
+	// No props
+	this := js.Global.Get("Object").New()
+	var p props
+	if err := UnmarshalProps(this, &p); err != nil || p != (props{}) {
+		t.Errorf("unexpected result for undefined props: %v %#v", err, p)
+	}
+
+	this.Set("props", nil)
+	if err := UnmarshalProps(this, &p); err != nil || p != (props{}) {
+		t.Errorf("unexpected result for null props: %v %#v", err, p)
+	}
+
+	// Plain javascript object
+	obj := js.Global.Get("Object").New()
+	obj.Set("name", "Gopher")
+	this.Set("props", obj)
+	if err := UnmarshalProps(this, &p); err != nil || p.Name != "Gopher" {
+		t.Errorf("unexpected result for a plain object: %v %#v", err, p)
+	}
+
+	// Not object-like
+	this.Set("state", 5)
+	var st props
+	if err := UnmarshalState(this, &st); err == nil {
+		t.Errorf("expected an error for a number")
+	}
+}
+
+func TestUnmarshalStructDefaults(t *testing.T) {
+
+	type props struct {
+		Size  string   `react:"size" default:"medium"`
+		Count int      `react:"count" default:"1"`
+		Tags  []string `react:"tags" default:"a,b"`
+	}
+
+	var p props
+	if err := UnmarshalStruct(map[string]interface{}{}, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Size != "medium" || p.Count != 1 || !reflect.DeepEqual(p.Tags, []string{"a", "b"}) {
+		t.Errorf("defaults were not applied: %#v", p)
+	}
+
+	// An explicit zero value is respected
+	p = props{}
+	if err := UnmarshalStruct(map[string]interface{}{"size": "", "count": 0}, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Size != "" || p.Count != 0 {
+		t.Errorf("explicit zero values were overridden: %#v", p)
+	}
+}
+
+func TestSToMapCamelCase(t *testing.T) {
+
+	type props struct {
+		BackgroundColor string
+		Tagged          string `react:"Tagged"`
+		OptionsOnly     string `react:",omitempty"`
+	}
+
+	mp := SToMap(props{"red", "a", "b"}, WithCamelCase())
+	expected := map[string]interface{}{"backgroundColor": "red", "Tagged": "a", "OptionsOnly": "b"}
+	if !reflect.DeepEqual(mp, expected) {
+		t.Errorf("expected %v, got %v", expected, mp)
+	}
+
+	// Off by default
+	if _, exists := SToMap(props{})["BackgroundColor"]; !exists {
+		t.Errorf("field names should not be changed by default")
+	}
+}
+
+func TestSToMapTypedMaps(t *testing.T) {
+
+	type point struct {
+		X int `react:"x"`
+		Y int `react:"y"`
+	}
+
+	type props struct {
+		Aria   map[string]string `react:"aria"`
+		Counts map[string]int    `react:"counts"`
+		Points map[string]point  `react:"points"`
+		Nil    map[string]string `react:"nil"`
+	}
+
+	type untyped struct {
+		Aria   map[string]interface{} `react:"aria"`
+		Counts map[string]interface{} `react:"counts"`
+		Points map[string]interface{} `react:"points"`
+		Nil    map[string]interface{} `react:"nil"`
+	}
+
+	mp := SToMap(props{
+		Aria:   map[string]string{"aria-label": "Close"},
+		Counts: map[string]int{"a": 1},
+		Points: map[string]point{"origin": {1, 2}},
+	})
+
+	expected := SToMap(untyped{
+		Aria:   map[string]interface{}{"aria-label": "Close"},
+		Counts: map[string]interface{}{"a": 1},
+		Points: map[string]interface{}{"origin": map[string]interface{}{"x": 1, "y": 2}},
+	})
+
+	if !reflect.DeepEqual(mp, expected) {
+		t.Errorf("expected %v, got %v", expected, mp)
+	}
+
+	if mp["nil"] != nil {
+		t.Errorf("expected a nil map to be null, got %v", mp["nil"])
+	}
+}
+
+func TestWithKeys(t *testing.T) {
+
+	type todo struct {
+		ID   int    `react:"id"`
+		Text string `react:"text"`
+	}
+
+	mps, err := WithKeysE([]todo{{1, "a"}, {2, "b"}}, "ID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mps) != 2 || mps[0]["key"] != "1" || mps[1]["key"] != "2" || mps[1]["text"] != "b" {
+		t.Errorf("unexpected maps: %v", mps)
+	}
+
+	if _, err := WithKeysE([]todo{{1, "a"}, {1, "b"}}, "id"); err == nil {
+		t.Errorf("expected an error for duplicate keys")
+	}
+
+	if _, err := WithKeysE([]todo{{0, "a"}}, "ID"); err == nil {
+		t.Errorf("expected an error for a zero key")
+	}
+
+	if _, err := WithKeysE([]todo{{1, "a"}}, "Missing"); err == nil {
+		t.Errorf("expected an error for a missing key field")
+	}
+
+	type keyed struct {
+		ID string `react:"id,key"`
+	}
+	if mp := SToMap(keyed{"x"}); mp["key"] != "x" || mp["id"] != "x" {
+		t.Errorf("expected the key prop to be added: %v", mp)
+	}
+}
+
+func TestJSONMarshalRoundTrip(t *testing.T) {
+
+	in := rtProps{
+		RTEmbedded: RTEmbedded{ID: "abc"},
+		Title:      "title",
+		Count:      3,
+		Ratio:      0.5,
+		Enabled:    true,
+		Child:      rtChild{Name: "child", Age: 7},
+		Children:   []rtChild{{Name: "a", Age: 1}},
+	}
+
+	s, err := JSONMarshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err := JSONUnmarshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The tag names are used
+	if obj.Get("child").Get("name").String() != "child" {
+		t.Errorf("expected tag names to be used, got: %s", s)
+	}
+
+	var out rtProps
+	if err := UnmarshalStruct(obj.Interface().(map[string]interface{}), &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out.ID != in.ID || out.Title != in.Title || out.Count != in.Count || out.Ratio != in.Ratio ||
+		out.Enabled != in.Enabled || out.Child != in.Child || !reflect.DeepEqual(out.Children, in.Children) {
+		t.Errorf("round trip mismatch:\n got: %#v\nwant: %#v", out, in)
+	}
+
+	// *js.Object is passed to JSON.stringify directly
+	if s2, err := JSONMarshal(obj); err != nil || s2 != s {
+		t.Errorf("expected %s, got: %s (%v)", s, s2, err)
+	}
+}
+
+type rtTextKey struct{ a, b string }
+
+func (k rtTextKey) MarshalText() ([]byte, error) {
+	return []byte(k.a + "-" + k.b), nil
+}
+
+func TestJSONMarshalMapKeys(t *testing.T) {
+
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{map[int]string{1: "a"}, `{"1":"a"}`},
+		{map[uint8]string{2: "b"}, `{"2":"b"}`},
+		{map[testSize]int{"m": 3}, `{"m":3}`},
+		{map[rtTextKey]bool{{"x", "y"}: true}, `{"x-y":true}`},
+	}
+
+	for _, tc := range tests {
+		if s, err := JSONMarshal(tc.in); err != nil || s != tc.want {
+			t.Errorf("expected %s, got: %s (%v)", tc.want, s, err)
+		}
+	}
+
+	// Unsupported keys return an error
+	if _, err := JSONMarshal(map[float64]string{1.5: "a"}); err == nil {
+		t.Errorf("expected an error for float keys")
+	} else if _, ok := err.(*ConversionError); !ok {
+		t.Errorf("expected a *ConversionError, got: %T", err)
+	}
+}
+
+type testSize string
+
+func TestRegisterEnum(t *testing.T) {
+
+	type props struct {
+		Size  testSize   `react:"size"`
+		Sizes []testSize `react:"sizes"`
+	}
+
+	// Unregistered types accept any value
+	var out props
+	if err := UnmarshalStruct(map[string]interface{}{"size": "huge"}, &out); err != nil || out.Size != "huge" {
+		t.Fatalf("unexpected result: %#v (%v)", out, err)
+	}
+
+	RegisterEnum[testSize]("s", "m", "l")
+	defer delete(enums, reflect.TypeOf(testSize("")))
+
+	if err := UnmarshalStruct(map[string]interface{}{"size": "m", "sizes": []interface{}{"s", "l"}}, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []map[string]interface{}{
+		{"size": "huge"},
+		{"sizes": []interface{}{"s", "huge"}},
+	}
+	for _, mp := range tests {
+		err := UnmarshalStruct(mp, &out)
+		if err == nil {
+			t.Fatalf("expected an error for %#v", mp)
+		}
+		if msg := err.Error(); !strings.Contains(msg, `"huge"`) || !strings.Contains(msg, "size") {
+			t.Errorf("expected the error to name the field and value, got: %s", msg)
+		}
+	}
+}
+
+func TestSToMapOmitFunc(t *testing.T) {
+
+	type props struct {
+		Disabled bool   `react:"disabled"`
+		Checked  *bool  `react:"checked"`
+		Title    string `react:"title,omitempty"`
+		Skipped  string `react:"-"`
+		Child    rtChild
+	}
+
+	called := map[string]bool{}
+	omit := WithOmitFunc(func(fieldName string, value interface{}) bool {
+		called[fieldName] = true
+		return value == false || fieldName == "Age"
+	})
+
+	checked := false
+	mp := SToMap(props{Checked: &checked, Child: rtChild{Name: "child", Age: 3}}, omit)
+
+	if _, exists := mp["disabled"]; exists {
+		t.Errorf("expected disabled to be omitted: %#v", mp)
+	}
+	if _, exists := mp["checked"]; !exists {
+		t.Errorf("expected checked to be kept: %#v", mp)
+	}
+
+	// fn receives the Go field names of the exported fields that are not omitted
+	for _, name := range []string{"Disabled", "Checked", "Child", "Name", "Age"} {
+		if !called[name] {
+			t.Errorf("expected fn to be called for %s: %#v", name, called)
+		}
+	}
+	// omitempty applies first, so fn is not called for a zero Title
+	if called["Title"] || called["Skipped"] || called["disabled"] {
+		t.Errorf("unexpected calls: %#v", called)
+	}
+
+	// Nested structs use the same options
+	if child, ok := mp["Child"].(map[string]interface{}); !ok || child["name"] != "child" || child["age"] != nil {
+		t.Errorf("unexpected child: %#v", mp["Child"])
+	}
+}
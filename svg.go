@@ -0,0 +1,132 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// UseAnimatedSVG is a hook that sets the attributes of the SVG element that ref is
+// attached to. Only the attributes that changed since the previous render are set
+// (using setAttribute), which avoids re-rendering the element on every frame of an animation.
+//
+// Example:
+//
+//  ref := react.UseRef[*js.Object](nil)
+//  react.UseAnimatedSVG(ref, map[string]string{"cx": cx, "cy": cy})
+//
+//  react.JSX("circle", map[string]interface{}{"ref": ref.O, "r": 5})
+//
+func UseAnimatedSVG(ref *Ref[*js.Object], attrs map[string]string) {
+
+	type animatedSVGState struct {
+		elem    *js.Object
+		applied map[string]string
+	}
+
+	st := useGoRef(func() interface{} {
+		return &animatedSVGState{}
+	}).(*animatedSVGState)
+
+	useLayoutEffect(func() func() {
+		elem := ref.Current()
+		if elem == nil {
+			return nil
+		}
+
+		if elem != st.elem {
+			// The element was replaced, so all the attributes must be set
+			st.elem = elem
+			st.applied = map[string]string{}
+		}
+
+		for name, value := range attrs {
+			if prev, exists := st.applied[name]; exists && prev == value {
+				continue
+			}
+			elem.Call("setAttribute", name, value)
+			st.applied[name] = value
+		}
+		return nil
+	}, nil)
+}
+
+// PathPoint is a point of an SVG path. Type is the command used to reach the point
+// (eg. "M", "L" or "T"). If Type is empty, "M" is used for the first point and "L"
+// for the others. "Z" closes the path (X and Y are ignored).
+type PathPoint struct {
+	X    float64
+	Y    float64
+	Type string
+}
+
+// SVGPath returns the d attribute of an SVG path that passes through points.
+func SVGPath(points []PathPoint) string {
+	var b strings.Builder
+	for i, p := range points {
+		cmd := p.Type
+		if cmd == "" {
+			if i == 0 {
+				cmd = "M"
+			} else {
+				cmd = "L"
+			}
+		}
+
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(cmd)
+
+		if cmd == "Z" || cmd == "z" {
+			continue
+		}
+		b.WriteString(strconv.FormatFloat(p.X, 'f', -1, 64))
+		b.WriteByte(',')
+		b.WriteString(strconv.FormatFloat(p.Y, 'f', -1, 64))
+	}
+	return b.String()
+}
+
+// UseSVGPath is a hook that returns the d attribute of an SVG path that passes through
+// points (see SVGPath). It is only computed again when points changes.
+//
+// Example:
+//
+//  d := react.UseSVGPath(points)
+//  react.JSX("path", map[string]interface{}{"d": d, "fill": "none", "stroke": "steelblue"})
+//
+func UseSVGPath(points []PathPoint) string {
+
+	type svgPathState struct {
+		points []PathPoint
+		d      string
+		init   bool
+	}
+
+	st := useGoRef(func() interface{} {
+		return &svgPathState{}
+	}).(*svgPathState)
+
+	if !st.init || !pathPointsEqual(st.points, points) {
+		st.points = append([]PathPoint(nil), points...)
+		st.d = SVGPath(points)
+		st.init = true
+	}
+	return st.d
+}
+
+func pathPointsEqual(a, b []PathPoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
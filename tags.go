@@ -0,0 +1,85 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strings"
+)
+
+// tagOptions are the comma separated options that follow
+// the name in a struct tag.
+type tagOptions []string
+
+// parseTag splits a struct tag into its name and options. Spaces around the
+// name and options are removed, and empty and duplicate options are dropped.
+// Unknown options are kept (they are ignored by the callers).
+func parseTag(tag string) (string, tagOptions) {
+	splits := strings.Split(tag, ",")
+
+	opts := tagOptions{}
+	for _, opt := range splits[1:] {
+		opt = strings.TrimSpace(opt)
+		if opt != "" && !opts.has(opt) {
+			opts = append(opts, opt)
+		}
+	}
+	return strings.TrimSpace(splits[0]), opts
+}
+
+// fieldKey returns the key of the struct field f (with tag) in the map, and whether the
+// fields of f are squashed into the parent map. Both SToMap (see cachedFields) and
+// UnmarshalStruct (see reactFieldKey) use it, so that they agree on every tag:
+//
+// A tag of "-" skips the field (the key is "-"). A tag with an empty name (eg. ",omitempty")
+// uses the field's name. Untagged embedded structs, and structs with the "inline" or
+// "squash" option, are squashed.
+func fieldKey(f reflect.StructField, tag string, camelCase bool) (key string, squash bool) {
+
+	if tag == "-" {
+		return "-", false
+	}
+
+	name, opts := parseTag(tag)
+
+	key = f.Name
+	if name != "" {
+		key = name
+	} else if tag == "" && camelCase {
+		key = lowerFirst(f.Name)
+	}
+
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct {
+		squash = (f.Anonymous && name == "") || opts.has("inline") || opts.has("squash")
+	}
+	return key, squash
+}
+
+// reactFieldKey is mapstructure's FieldKey for the "react" tag.
+func reactFieldKey(f reflect.StructField) (string, bool) {
+	return fieldKey(f, f.Tag.Get("react"), false)
+}
+
+// has returns true if opt is present.
+func (o tagOptions) has(opt string) bool {
+	for _, s := range o {
+		if s == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// value returns the value of an option in the form "opt=value".
+func (o tagOptions) value(opt string) (string, bool) {
+	for _, s := range o {
+		if strings.HasPrefix(s, opt+"=") {
+			return s[len(opt)+1:], true
+		}
+	}
+	return "", false
+}
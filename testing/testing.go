@@ -0,0 +1,369 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+// Package testing provides utilities for asserting what a component renders without a
+// browser. It must be used with GopherJS (eg. gopherjs test in Node.js). Since its name
+// clashes with the standard library, it is usually imported with another name.
+//
+// Example:
+//
+//  import rtesting "github.com/rocketlaunchr/react/testing"
+//
+//  func TestGreeting(t *testing.T) {
+//      out := rtesting.ShallowRender(react.JSX(Greeting, &GreetingProps{Name: "John"}))
+//
+//      if out.Type() != "div" {
+//          t.Errorf("expected a div, got %s", out.Type())
+//      }
+//      if h := out.Find("h1.title"); len(h) != 1 || h[0].Text() != "Hello John" {
+//          t.Errorf("unexpected title")
+//      }
+//  }
+//
+package testing
+
+import (
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ShallowRenderer points to the ShallowRenderer of react-test-renderer. If it is not set,
+// it is loaded using require("react-test-renderer/shallow") when first used (if available).
+// Without it, only functional components that don't use hooks can be rendered.
+//
+// See: https://reactjs.org/docs/shallow-renderer.html
+var ShallowRenderer *js.Object
+
+// shallowRenderer returns ShallowRenderer, or nil if it isn't available.
+func shallowRenderer() (renderer *js.Object) {
+	if ShallowRenderer != nil && ShallowRenderer != js.Undefined {
+		return ShallowRenderer
+	}
+
+	if global := js.Global.Get("ReactShallowRenderer"); global != js.Undefined {
+		ShallowRenderer = global
+		return ShallowRenderer
+	}
+
+	if js.Global.Get("require") == js.Undefined {
+		return nil
+	}
+
+	defer func() {
+		// react-test-renderer is not installed
+		if e := recover(); e != nil {
+			if _, ok := e.(*js.Error); !ok {
+				panic(e)
+			}
+			renderer = nil
+		}
+	}()
+
+	mod := js.Global.Call("require", "react-test-renderer/shallow")
+	if def := mod.Get("default"); def != js.Undefined {
+		mod = def
+	}
+	ShallowRenderer = mod
+	return ShallowRenderer
+}
+
+// ShallowRender renders element one level deep: if element is a component, the element it
+// renders is returned (the components it contains are not rendered). Otherwise, element
+// is returned as is. nil is returned if the component renders nothing.
+func ShallowRender(element *js.Object) *RenderedOutput {
+	if isNil(element) {
+		return nil
+	}
+
+	typ := element.Get("type")
+	if jsTypeOf(typ) == "function" {
+		if renderer := shallowRenderer(); renderer != nil {
+			return newRenderedOutput(renderer.New().Call("render", element))
+		}
+
+		props := element.Get("props")
+		if proto := typ.Get("prototype"); !isNil(proto) && !isNil(proto.Get("isReactComponent")) {
+			// Class component (lifecycle methods are not called)
+			return newRenderedOutput(typ.New(props).Call("render"))
+		}
+		// Call the functional component directly
+		return newRenderedOutput(typ.Invoke(props))
+	}
+
+	return newRenderedOutput(element)
+}
+
+// RenderedOutput is a node of the output of ShallowRender. It is either a React element or text.
+type RenderedOutput struct {
+	// O is the React element (nil for text).
+	O *js.Object
+
+	text     string
+	children []*RenderedOutput // cached by Children
+}
+
+// newRenderedOutput returns the node of a rendered value. nil is returned for
+// values that render nothing (eg. null and booleans).
+func newRenderedOutput(o *js.Object) *RenderedOutput {
+	if isNil(o) {
+		return nil
+	}
+
+	switch jsTypeOf(o) {
+	case "string":
+		return &RenderedOutput{text: o.String()}
+	case "number":
+		return &RenderedOutput{text: js.Global.Get("String").Invoke(o).String()}
+	case "object":
+		if o.Get("$$typeof") != js.Undefined {
+			return &RenderedOutput{O: o}
+		}
+	}
+	return nil
+}
+
+// Type returns the element's type: the tag name for DOM elements (eg. "div"),
+// the displayName (or name) for components, "Fragment" for fragments and
+// "#text" for text.
+func (r *RenderedOutput) Type() string {
+	if r.O == nil {
+		return "#text"
+	}
+	return typeName(r.O.Get("type"))
+}
+
+func typeName(typ *js.Object) string {
+	switch jsTypeOf(typ) {
+	case "string":
+		return typ.String()
+	case "symbol":
+		desc := js.Global.Get("String").Invoke(typ).String()
+		if strings.Contains(desc, "fragment") {
+			return "Fragment"
+		}
+		return desc
+	}
+
+	if name := typ.Get("displayName"); !isNil(name) && name.String() != "" {
+		return name.String()
+	}
+	if name := typ.Get("name"); !isNil(name) && name.String() != "" {
+		return name.String()
+	}
+
+	// React.memo and React.forwardRef
+	if inner := typ.Get("type"); !isNil(inner) {
+		return typeName(inner)
+	}
+	if inner := typ.Get("render"); !isNil(inner) {
+		return typeName(inner)
+	}
+	return "Component"
+}
+
+// Text returns the text of a text node, or the text of an element's descendants.
+func (r *RenderedOutput) Text() string {
+	if r.O == nil {
+		return r.text
+	}
+
+	var sb strings.Builder
+	for _, c := range r.Children() {
+		sb.WriteString(c.Text())
+	}
+	return sb.String()
+}
+
+// Key returns the element's key (or an empty string).
+func (r *RenderedOutput) Key() string {
+	if r.O == nil || isNil(r.O.Get("key")) {
+		return ""
+	}
+	return r.O.Get("key").String()
+}
+
+// Props returns the element's props (excluding children).
+func (r *RenderedOutput) Props() map[string]interface{} {
+	out := map[string]interface{}{}
+	if r.O == nil {
+		return out
+	}
+
+	props := r.O.Get("props")
+	if isNil(props) {
+		return out
+	}
+
+	keys := js.Global.Get("Object").Call("keys", props)
+	for i := 0; i < keys.Length(); i++ {
+		key := keys.Index(i).String()
+		if key == "children" {
+			continue
+		}
+		out[key] = props.Get(key).Interface()
+	}
+	return out
+}
+
+// Prop returns the value of the element's prop key (or nil).
+func (r *RenderedOutput) Prop(key string) interface{} {
+	return r.Props()[key]
+}
+
+// Children returns the element's children. Arrays of children are flattened and
+// children that render nothing are left out.
+func (r *RenderedOutput) Children() []*RenderedOutput {
+	if r.O == nil {
+		return nil
+	}
+	if r.children == nil {
+		r.children = []*RenderedOutput{}
+		appendChildren(&r.children, r.O.Get("props").Get("children"))
+	}
+	return r.children
+}
+
+func appendChildren(out *[]*RenderedOutput, children *js.Object) {
+	if isNil(children) {
+		return
+	}
+
+	if js.Global.Get("Array").Call("isArray", children).Bool() {
+		for i := 0; i < children.Length(); i++ {
+			appendChildren(out, children.Index(i))
+		}
+		return
+	}
+
+	if c := newRenderedOutput(children); c != nil {
+		*out = append(*out, c)
+	}
+}
+
+// Find returns the descendants that match selector, in document order. A selector is a
+// list of compound selectors separated by spaces (for descendants), where a compound
+// selector is an optional type (a tag name or component name) followed by any number of
+// ".className" and "#id" (eg. "ul li.active" or "Button#submit").
+func (r *RenderedOutput) Find(selector string) []*RenderedOutput {
+	parts := parseSelector(selector)
+	if len(parts) == 0 {
+		return nil
+	}
+
+	out := []*RenderedOutput{}
+	seen := map[*RenderedOutput]bool{}
+	for _, n := range find(r, parts) {
+		if !seen[n] {
+			seen[n] = true
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func find(root *RenderedOutput, parts []compoundSelector) []*RenderedOutput {
+	out := []*RenderedOutput{}
+
+	var walk func(n *RenderedOutput)
+	walk = func(n *RenderedOutput) {
+		for _, c := range n.Children() {
+			if parts[0].matches(c) {
+				if len(parts) == 1 {
+					out = append(out, c)
+				} else {
+					out = append(out, find(c, parts[1:])...)
+				}
+			}
+			walk(c)
+		}
+	}
+	walk(root)
+	return out
+}
+
+type compoundSelector struct {
+	typ     string
+	id      string
+	classes []string
+}
+
+func parseSelector(selector string) []compoundSelector {
+	parts := []compoundSelector{}
+
+	for _, s := range strings.Fields(selector) {
+		var cs compoundSelector
+
+		// Split before each "." and "#"
+		start := 0
+		for i := 0; i <= len(s); i++ {
+			if i < len(s) && (i == 0 || (s[i] != '.' && s[i] != '#')) {
+				continue
+			}
+
+			token := s[start:i]
+			switch {
+			case token == "":
+			case token[0] == '.':
+				cs.classes = append(cs.classes, token[1:])
+			case token[0] == '#':
+				cs.id = token[1:]
+			default:
+				cs.typ = token
+			}
+			start = i
+		}
+		parts = append(parts, cs)
+	}
+	return parts
+}
+
+func (cs compoundSelector) matches(n *RenderedOutput) bool {
+	if n.O == nil {
+		return false
+	}
+	if cs.typ != "" && cs.typ != n.Type() {
+		return false
+	}
+
+	props := n.O.Get("props")
+	if cs.id != "" {
+		if id := props.Get("id"); isNil(id) || id.String() != cs.id {
+			return false
+		}
+	}
+
+	if len(cs.classes) > 0 {
+		className := props.Get("className")
+		if isNil(className) {
+			return false
+		}
+		classes := strings.Fields(className.String())
+		for _, want := range cs.classes {
+			found := false
+			for _, c := range classes {
+				if c == want {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isNil(o *js.Object) bool {
+	return o == nil || o == js.Undefined
+}
+
+var typeOf *js.Object
+
+// jsTypeOf returns the result of the typeof operator.
+func jsTypeOf(o *js.Object) string {
+	if typeOf == nil {
+		typeOf = js.Global.Get("Function").New("v", "return typeof v")
+	}
+	return typeOf.Invoke(o).String()
+}
@@ -0,0 +1,111 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/rocketlaunchr/react"
+	rtesting "github.com/rocketlaunchr/react/testing"
+)
+
+// These tests must be run using gopherjs test in Node.js with react installed.
+
+func init() {
+	react.React = js.Global.Call("require", "react")
+}
+
+// card renders a title and a list of items.
+var card = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+	props := arguments[0]
+	return react.JSX("div", map[string]interface{}{"className": "card", "id": "main"},
+		react.JSX("h1", map[string]interface{}{"className": "title big"}, "Hello ", props.Get("name").String()),
+		react.JSX("ul", nil,
+			react.JSX("li", map[string]interface{}{"key": "a", "className": "item active"}, "A"),
+			react.JSX("li", map[string]interface{}{"key": "b", "className": "item"}, "B"),
+		),
+	)
+})
+
+func TestShallowRenderType(t *testing.T) {
+
+	out := rtesting.ShallowRender(react.JSX(card, map[string]interface{}{"name": "John"}))
+	if out == nil {
+		t.Fatalf("expected output")
+	}
+
+	if out.Type() != "div" {
+		t.Errorf("expected div, got %s", out.Type())
+	}
+
+	// Elements that aren't components are returned as is
+	if typ := rtesting.ShallowRender(react.JSX("span", nil)).Type(); typ != "span" {
+		t.Errorf("expected span, got %s", typ)
+	}
+}
+
+func TestShallowRenderProps(t *testing.T) {
+
+	out := rtesting.ShallowRender(react.JSX(card, map[string]interface{}{"name": "John"}))
+
+	props := out.Props()
+	if props["className"] != "card" || props["id"] != "main" {
+		t.Errorf("unexpected props: %#v", props)
+	}
+	if _, exists := props["children"]; exists {
+		t.Errorf("children should not be in props")
+	}
+}
+
+func TestShallowRenderChildren(t *testing.T) {
+
+	out := rtesting.ShallowRender(react.JSX(card, map[string]interface{}{"name": "John"}))
+
+	children := out.Children()
+	if len(children) != 2 || children[0].Type() != "h1" || children[1].Type() != "ul" {
+		t.Fatalf("unexpected children")
+	}
+
+	if text := children[0].Text(); text != "Hello John" {
+		t.Errorf("expected %q, got %q", "Hello John", text)
+	}
+
+	items := children[1].Children()
+	if len(items) != 2 || items[0].Key() != "a" || items[1].Text() != "B" {
+		t.Errorf("unexpected items")
+	}
+}
+
+func TestFind(t *testing.T) {
+
+	out := rtesting.ShallowRender(react.JSX(card, map[string]interface{}{"name": "John"}))
+
+	tests := []struct {
+		selector string
+		count    int
+	}{
+		{"li", 2},
+		{".item", 2},
+		{"li.item.active", 1},
+		{"ul li", 2},
+		{"h1.title", 1},
+		{".title.big", 1},
+		{"#main", 0}, // the root is not a descendant
+		{"ul h1", 0},
+		{"span", 0},
+	}
+
+	for _, tc := range tests {
+		if found := out.Find(tc.selector); len(found) != tc.count {
+			t.Errorf("%q: expected %d, got %d", tc.selector, tc.count, len(found))
+		}
+	}
+
+	if found := out.Find("li.active"); len(found) != 1 || found[0].Text() != "A" {
+		t.Errorf("expected the active item")
+	}
+}
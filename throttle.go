@@ -0,0 +1,153 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// throttle records the state of UseThrottledValue between renders.
+type throttle struct {
+	value   interface{} // last value returned
+	pending interface{} // latest value not yet returned
+	last    time.Time
+	stop    func() bool // stops the pending timer (nil if there is none)
+	renders int
+}
+
+// The clock used by UseThrottledValue. Tests replace it with a fake clock.
+var (
+	throttleNow       = time.Now
+	throttleAfterFunc = func(d time.Duration, f func()) (stop func() bool) {
+		return time.AfterFunc(d, f).Stop
+	}
+)
+
+// UseThrottledValue is a hook that returns value at most once per interval.
+// While throttled, the previously returned value is returned and the latest value
+// is delivered at the end of the interval (trailing edge) by re-rendering the component.
+//
+// equal is optionally used to skip updates when the value hasn't meaningfully changed.
+// The default is reflect.DeepEqual. Pending updates are cancelled when the component unmounts.
+//
+// Example:
+//
+//  reading := react.UseThrottledValue(props.Get("reading").Float(), 50*time.Millisecond)
+//
+func UseThrottledValue(value interface{}, interval time.Duration, equal ...func(a, b interface{}) bool) interface{} {
+
+	eq := reflect.DeepEqual
+	if len(equal) > 0 && equal[0] != nil {
+		eq = equal[0]
+	}
+
+	t := useGoRef(func() interface{} {
+		return &throttle{value: value, last: throttleNow()}
+	}).(*throttle)
+
+	_, setRenders := useState(0)
+
+	useEffect(func() func() {
+		return func() {
+			// Unmounting
+			if t.stop != nil {
+				t.stop()
+				t.stop = nil
+			}
+		}
+	}, []interface{}{})
+
+	if eq(value, t.value) {
+		// Nothing has changed since the last value was returned
+		if t.stop != nil {
+			t.stop()
+			t.stop = nil
+		}
+		return t.value
+	}
+
+	elapsed := throttleNow().Sub(t.last)
+	if elapsed >= interval && t.stop == nil {
+		t.value = value
+		t.last = throttleNow()
+		return value
+	}
+
+	t.pending = value
+	if t.stop == nil {
+		t.stop = throttleAfterFunc(interval-elapsed, func() {
+			t.stop = nil
+			t.value = t.pending
+			t.pending = nil
+			t.last = throttleNow()
+			t.renders++
+			setRenders(t.renders)
+		})
+	}
+
+	return t.value
+}
+
+// ThrottleRender is a higher-order component that re-renders component at most once
+// per interval with the latest props. It is useful when props change faster than
+// the component needs to paint (eg. telemetry).
+//
+// equal is optionally used to compare the previous and next props (as *js.Object).
+// The default is a shallow comparison.
+//
+// Example:
+//
+//  throttledChart := react.ThrottleRender(ChartComponent, 50*time.Millisecond)
+//  react.JSX(throttledChart, &ChartProps{Reading: reading})
+//
+func ThrottleRender(component interface{}, interval time.Duration, equal ...func(prevProps, nextProps *js.Object) bool) *js.Object {
+
+	eq := shallowEqual
+	if len(equal) > 0 && equal[0] != nil {
+		eq = equal[0]
+	}
+
+	memoized := React.Call("memo", component)
+
+	name := "Component"
+	if c, ok := component.(*js.Object); ok {
+		name = componentName(c)
+	}
+
+	return NamedComponent("Throttled("+name+")", func(this *js.Object, arguments []*js.Object) interface{} {
+		props := UseThrottledValue(arguments[0], interval, func(a, b interface{}) bool {
+			return eq(a.(*js.Object), b.(*js.Object))
+		})
+		return React.Call("createElement", memoized, props)
+	})
+}
+
+// shallowEqual returns true if the objects have the same keys and
+// the values are strictly equal.
+func shallowEqual(a, b *js.Object) bool {
+	if a == b {
+		return true
+	}
+
+	if a == nil || b == nil {
+		return false
+	}
+
+	keysA := js.Global.Get("Object").Call("keys", a)
+	keysB := js.Global.Get("Object").Call("keys", b)
+	if keysA.Length() != keysB.Length() {
+		return false
+	}
+
+	for i := 0; i < keysA.Length(); i++ {
+		k := keysA.Index(i).String()
+		if !b.Call("hasOwnProperty", k).Bool() || a.Get(k) != b.Get(k) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,123 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build js
+// +build js
+
+package react
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// These tests must be run using gopherjs test in Node.js with react, react-dom (16 or 17)
+// and jsdom installed.
+
+// fakeClock is a clock whose timers only fire when it is advanced.
+type fakeClock struct {
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	at      time.Time
+	f       func()
+	stopped bool
+}
+
+func (c *fakeClock) afterFunc(d time.Duration, f func()) func() bool {
+	t := &fakeTimer{at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return func() bool {
+		active := !t.stopped
+		t.stopped = true
+		return active
+	}
+}
+
+// advance moves the clock forward by d and fires the timers that are due.
+func (c *fakeClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+	for _, t := range c.timers {
+		if !t.stopped && !t.at.After(c.now) {
+			t.stopped = true
+			t.f()
+		}
+	}
+}
+
+// pending returns the number of timers that haven't fired or been stopped.
+func (c *fakeClock) pending() int {
+	n := 0
+	for _, t := range c.timers {
+		if !t.stopped {
+			n++
+		}
+	}
+	return n
+}
+
+func TestUseThrottledValue(t *testing.T) {
+	container := setupDOM(t)
+
+	clock := &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	throttleNow = func() time.Time { return clock.now }
+	throttleAfterFunc = clock.afterFunc
+	defer func() {
+		throttleNow = time.Now
+		throttleAfterFunc = func(d time.Duration, f func()) func() bool {
+			return time.AfterFunc(d, f).Stop
+		}
+	}()
+
+	renders := 0
+	var got interface{}
+
+	component := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		renders++
+		got = UseThrottledValue(arguments[0].Get("value").Int(), 100*time.Millisecond)
+		return nil
+	})
+
+	render := func(value int) {
+		ReactDOM.Call("render", JSX(component, map[string]interface{}{"value": value}), container)
+	}
+
+	render(0)
+
+	// Rapid updates within the interval return the previous value
+	for i := 1; i <= 5; i++ {
+		clock.advance(10 * time.Millisecond)
+		render(i)
+		if got != 0 {
+			t.Fatalf("%d: expected the throttled value 0, got %v", i, got)
+		}
+	}
+	if renders != 6 || clock.pending() != 1 {
+		t.Fatalf("expected 6 renders and 1 pending timer, got %d and %d", renders, clock.pending())
+	}
+
+	// The latest value is delivered at the end of the interval with a single render
+	clock.advance(50 * time.Millisecond)
+	if renders != 7 || got != 5 {
+		t.Errorf("expected 7 renders with value 5, got %d renders with value %v", renders, got)
+	}
+
+	// A pending update is cancelled when the component unmounts
+	render(6)
+	if clock.pending() != 1 {
+		t.Fatalf("expected a pending timer")
+	}
+	ReactDOM.Call("unmountComponentAtNode", container)
+	if clock.pending() != 0 {
+		t.Errorf("expected the pending timer to be cancelled on unmount")
+	}
+
+	rendersBefore := renders
+	clock.advance(time.Second)
+	if renders != rendersBefore {
+		t.Errorf("expected no renders after unmounting, got %d", renders-rendersBefore)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"strconv"
+	"time"
+)
+
+// RelativeTimeFunc is used by SToMap to humanize time.Time fields that
+// have the "relativetime" tag option. Change it to customize the output
+// (eg. for localization).
+//
+// Example:
+//
+//  type Props struct {
+//      Updated time.Time `react:"updated,relativetime"` // "2 hours ago"
+//      Created time.Time `react:"created,rfc3339"`      // "2020-05-01T10:00:00Z"
+//  }
+//
+var RelativeTimeFunc = relativeTime
+
+// relativeTime is the default RelativeTimeFunc.
+func relativeTime(t time.Time) string {
+
+	d := time.Since(t)
+
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	if d < 10*time.Second {
+		return "just now"
+	}
+
+	units := []struct {
+		d    time.Duration
+		name string
+	}{
+		{365 * 24 * time.Hour, "year"},
+		{30 * 24 * time.Hour, "month"},
+		{7 * 24 * time.Hour, "week"},
+		{24 * time.Hour, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+		{time.Second, "second"},
+	}
+
+	for _, u := range units {
+		if d < u.d {
+			continue
+		}
+
+		n := int(d / u.d)
+		s := strconv.Itoa(n) + " " + u.name
+		if n != 1 {
+			s = s + "s"
+		}
+
+		if future {
+			return "in " + s
+		}
+		return s + " ago"
+	}
+
+	return "just now"
+}
+
+// timeValue returns the time if v is a time.Time or a non-nil *time.Time.
+func timeValue(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t != nil {
+			return *t, true
+		}
+	}
+	return time.Time{}, false
+}
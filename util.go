@@ -3,7 +3,10 @@
 package react
 
 import (
+	"reflect"
 	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
 )
 
 // M is shorthand for map[string]interface{}.
@@ -42,9 +45,105 @@ func M(kvs ...interface{}) map[string]interface{} {
 // Set is used for conveniently dealing with
 // data-* and aria-* attributes.
 //
+// When used as a struct field, the field's tag is the base that is prefixed
+// to each attribute and the attributes are added to the props. The base is relative
+// to the final prop path, so the attributes of a Set inside a nested struct are also
+// prefixed with the path of the nested struct (eg. "style.color") and added to the
+// top-level props. If the tag has no name, the Set is skipped.
+// If the tag contains omitempty, an empty Set is skipped.
+//
+// Example:
+//
+//  type Style struct {
+//      Colors react.Set `react:"color-"`
+//  }
+//
+//  type Props struct {
+//      AriaSet react.Set `react:"aria-,omitempty"`
+//      Style   Style     `react:"style"` // Colors produces "style.color-*" props
+//  }
+//
 // See: https://reactjs.org/docs/dom-elements.html
 type Set map[string]string
 
+// NewSet creates a Set from pairs of attributes and values.
+//
+// Example:
+//
+//  react.NewSet("label", "Close", "hidden", "false")
+//
+func NewSet(kvs ...string) Set {
+
+	if len(kvs)%2 != 0 {
+		panic("react.NewSet must contain an even number of arguments")
+	}
+
+	out := Set{}
+	for idx := 0; idx < len(kvs); idx = idx + 2 {
+		out[kvs[idx]] = kvs[idx+1]
+	}
+
+	return out
+}
+
+// Add adds (or replaces) an attribute. The Set is initialized if it is nil.
+func (s *Set) Add(attr, val string) {
+	if *s == nil {
+		*s = Set{}
+	}
+	(*s)[attr] = val
+}
+
+// Delete removes an attribute.
+func (s Set) Delete(attr string) {
+	delete(s, attr)
+}
+
+// Len returns the number of attributes.
+func (s Set) Len() int {
+	return len(s)
+}
+
+// Merge returns a new Set containing the attributes of s and others.
+// If an attribute is present in more than one Set, the value of the last one is used.
+// s is not modified.
+//
+// Example:
+//
+//  data := base.Merge(stateData, overrides)
+//
+func (s Set) Merge(others ...Set) Set {
+
+	out := Set{}
+
+	for attr, val := range s {
+		out[attr] = val
+	}
+
+	for _, other := range others {
+		for attr, val := range other {
+			out[attr] = val
+		}
+	}
+
+	return out
+}
+
+// Diff returns a new Set containing the attributes of s that are not in other
+// or that have a different value in other. s is not modified.
+func (s Set) Diff(other Set) Set {
+
+	out := Set{}
+
+	for attr, val := range s {
+		if otherVal, exists := other[attr]; !exists || otherVal != val {
+			out[attr] = val
+		}
+	}
+
+	return out
+}
+
 // Convert is used to transform a set of suffix attributes
 // to the actual attributes by prefixing them with a base.
 func (s Set) Convert(base string) map[string]string {
@@ -58,6 +157,91 @@ func (s Set) Convert(base string) map[string]string {
 	return out
 }
 
+// MergeMaps returns a new map containing the keys of dst and src. If a key is in both
+// and both values are maps (including js.M, Set and other maps with string keys), they are
+// merged recursively. Otherwise the value of src wins, even if only one of the values is
+// a map or the value of src is nil. Neither dst nor src is modified, but values that are
+// not merged (eg. a map that is only in dst) are not copied. *js.Object values are never merged.
+// When both values are a Set, Style or ClassName, the result has the same type.
+//
+// It is useful for applying default props without clobbering nested objects such as style.
+//
+// Example:
+//
+//  defaults := map[string]interface{}{"size": "medium", "style": js.M{"color": "red", "margin": 0}}
+//  props := react.MergeMaps(defaults, map[string]interface{}{"style": js.M{"color": "blue"}})
+//  // map[size:medium style:map[color:blue margin:0]]
+//
+func MergeMaps(dst, src map[string]interface{}) map[string]interface{} {
+
+	out := make(map[string]interface{}, len(dst)+len(src))
+	for k, v := range dst {
+		out[k] = v
+	}
+
+	for k, srcVal := range src {
+		if dstVal, exists := out[k]; exists {
+			// Keep the types that SToMap treats specially
+			switch d := dstVal.(type) {
+			case Set:
+				if s, ok := srcVal.(Set); ok {
+					out[k] = d.Merge(s)
+					continue
+				}
+			case Style:
+				if s, ok := srcVal.(Style); ok {
+					out[k] = Style(MergeMaps(d, s))
+					continue
+				}
+			case ClassName:
+				if s, ok := srcVal.(ClassName); ok {
+					merged := ClassName{}
+					for c, on := range d {
+						merged[c] = on
+					}
+					for c, on := range s {
+						merged[c] = on
+					}
+					out[k] = merged
+					continue
+				}
+			}
+
+			dstMap, ok1 := mergeableMap(dstVal)
+			srcMap, ok2 := mergeableMap(srcVal)
+			if ok1 && ok2 {
+				out[k] = MergeMaps(dstMap, srcMap)
+				continue
+			}
+		}
+		out[k] = srcVal
+	}
+
+	return out
+}
+
+// mergeableMap returns v as a map[string]interface{} if it is a non-nil map with string keys.
+func mergeableMap(v interface{}) (map[string]interface{}, bool) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		return x, x != nil
+	case js.M:
+		return x, x != nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map || rv.IsNil() || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	out := make(map[string]interface{}, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		out[iter.Key().String()] = iter.Value().Interface()
+	}
+	return out, true
+}
+
 // DangerouslySetInnerHTMLFunc is a convience function used for setting the DOM
 // object's inner html. The functon takes a function for the argument.
 //
@@ -70,6 +254,54 @@ func DangerouslySetInnerHTMLFunc(inside func() interface{}) map[string]interface
 	}
 }
 
+// InnerHTMLOnce caches the inner html computed by a function.
+// It is created by DangerouslySetInnerHTMLOnce.
+//
+// NOTE: It is not safe for concurrent use. This is not a problem since
+// rendering is single-threaded in GopherJS.
+type InnerHTMLOnce struct {
+	inside   func() interface{}
+	computed bool
+	html     interface{}
+}
+
+// DangerouslySetInnerHTMLOnce is the same as DangerouslySetInnerHTMLFunc except that
+// the function is only called once. The result is reused until Invalidate is called.
+// The returned value must be kept between renders (eg. in a struct or a ref) and assigned
+// to a DangerouslySetInnerHTML field.
+//
+// Example:
+//
+//  type Props struct {
+//      DangerouslySetInnerHTML interface{} `react:"dangerouslySetInnerHTML"`
+//  }
+//
+//  html := react.DangerouslySetInnerHTMLOnce(func() interface{} {
+//      return renderMarkdown(src)
+//  })
+//
+//  react.JSX("div", &Props{DangerouslySetInnerHTML: html})
+//
+// See: https://reactjs.org/docs/dom-elements.html#dangerouslysetinnerhtml
+func DangerouslySetInnerHTMLOnce(inside func() interface{}) *InnerHTMLOnce {
+	return &InnerHTMLOnce{inside: inside}
+}
+
+// Invalidate forces the inner html to be computed again when it is next used.
+func (h *InnerHTMLOnce) Invalidate() {
+	h.computed = false
+	h.html = nil
+}
+
+// Map returns the inner html in the same form as DangerouslySetInnerHTML.
+func (h *InnerHTMLOnce) Map() map[string]interface{} {
+	if !h.computed {
+		h.html = h.inside()
+		h.computed = true
+	}
+	return DangerouslySetInnerHTML(h.html)
+}
+
 // DangerouslySetInnerHTML is a convience function used for setting the DOM
 // object's inner html. The function takes the inner html content directly.
 //
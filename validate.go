@@ -0,0 +1,185 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// MissingPropsError is returned when fields with the "required" tag option are missing.
+//
+// Example:
+//
+//  type Props struct {
+//      UserID string `react:"userId,required"`
+//  }
+//
+type MissingPropsError struct {
+	// Fields contains the names (from the tag) of the missing fields.
+	Fields []string
+}
+
+// Error implements the error interface.
+func (e *MissingPropsError) Error() string {
+	return "react: missing required props: " + strings.Join(e.Fields, ", ")
+}
+
+// Validate returns a *MissingPropsError if fields of strct with the "required" tag option
+// have a zero value. It is useful for checking a struct that was constructed manually.
+// strct must be a struct or a pointer to a struct.
+func Validate(strct interface{}) error {
+
+	s := reflect.Indirect(reflect.ValueOf(strct))
+
+	missing := []string{}
+	for _, f := range requiredFields(s.Type()) {
+		if s.Field(f.index).IsZero() {
+			missing = append(missing, f.key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingPropsError{Fields: missing}
+	}
+	return nil
+}
+
+// checkRequired returns a *MissingPropsError if the keys of fields of strct
+// with the "required" tag option are missing from mp or are null or undefined.
+func checkRequired(mp map[string]interface{}, strct interface{}) error {
+
+	t := reflect.TypeOf(strct)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	missing := []string{}
+	for _, f := range requiredFields(t) {
+		if v := lookupKey(mp, f.key); v == nil || jsObjectIsNil(v) || v == js.Undefined {
+			missing = append(missing, f.key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingPropsError{Fields: missing}
+	}
+	return nil
+}
+
+type requiredField struct {
+	index int
+	key   string
+}
+
+// requiredFields returns the fields with the "required" tag option.
+func requiredFields(t reflect.Type) []requiredField {
+
+	out := []requiredField{}
+	for _, fi := range cachedFields(t, defaultOptions) {
+		if !fi.tagOpts.has("required") {
+			continue
+		}
+
+		out = append(out, requiredField{fi.index, fi.key})
+	}
+	return out
+}
+
+// lookupKey returns the value of key in mp. Just like mapstructure, a
+// case-insensitive match is used if there is no exact match.
+func lookupKey(mp map[string]interface{}, key string) interface{} {
+	if v, exists := mp[key]; exists {
+		return v
+	}
+	for k, v := range mp {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+// PropValidator is used to check the props of a class component while developing.
+// See ClassDef.SetPropValidator.
+type PropValidator interface {
+	ValidateProps(props map[string]interface{}) []error
+}
+
+// PropValidatorFunc is a func that implements PropValidator.
+type PropValidatorFunc func(props map[string]interface{}) []error
+
+// ValidateProps implements PropValidator.
+func (f PropValidatorFunc) ValidateProps(props map[string]interface{}) []error {
+	return f(props)
+}
+
+// propValidatorKey stores the PropValidator in a ClassDef. It is removed by CreateClass.
+const propValidatorKey = "__propValidator"
+
+// SetPropValidator sets v to check the component's props after it mounts and updates.
+// The errors are printed to the console. Just like React's propTypes, the props are
+// only checked if Development is true, so there is no cost in production.
+//
+// Example:
+//
+//  appDef.SetPropValidator(react.PropValidatorFunc(func(props map[string]interface{}) []error {
+//      if _, exists := props["user"]; !exists {
+//          return []error{errors.New("user is required")}
+//      }
+//      return nil
+//  }))
+//
+func (def ClassDef) SetPropValidator(v PropValidator) {
+	if v == nil {
+		delete(def, propValidatorKey)
+		return
+	}
+	def[propValidatorKey] = v
+}
+
+// withPropValidator returns a copy of def whose componentDidMount and componentDidUpdate
+// methods call the PropValidator (if there is one) when Development is true.
+func (def ClassDef) withPropValidator() ClassDef {
+
+	v, ok := def[propValidatorKey].(PropValidator)
+	if !ok {
+		return def
+	}
+
+	out := ClassDef{}
+	for k, val := range def {
+		if k != propValidatorKey {
+			out[k] = val
+		}
+	}
+
+	if !Development {
+		return out
+	}
+
+	displayName, _ := def["displayName"].(string)
+
+	for _, name := range []string{componentDidMount, componentDidUpdate} {
+		original, _ := def[name].(*js.Object)
+
+		out[name] = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			props, _ := this.Get("props").Interface().(map[string]interface{})
+			for _, err := range v.ValidateProps(props) {
+				js.Global.Get("console").Call("error", "react: "+displayName+": invalid props: "+err.Error())
+			}
+
+			if original == nil {
+				return nil
+			}
+			return original.Call("apply", this, arguments)
+		})
+	}
+
+	return out
+}
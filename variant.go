@@ -0,0 +1,84 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// variantClasses stores the tables registered with RegisterVariantClasses.
+var variantClasses = map[string]map[string]string{}
+
+// RegisterVariantClasses registers a table that maps the values of a variant to classes.
+// It is used by the "variant" tag option. When a struct is converted by SToMap, the value of
+// a field with the option is looked up in the table and the classes are added to className.
+// The field itself is not added to the props. An empty key in the table is used for the field's
+// zero value (or a nil pointer). Values that are not in the table add no classes.
+//
+// Example:
+//
+//  react.RegisterVariantClasses("size", map[string]string{
+//      "sm": "text-sm px-2",
+//      "lg": "text-lg px-4",
+//  })
+//
+//  type ButtonProps struct {
+//      ClassName string `react:"className,omitempty"`
+//      Size      string `react:",variant=size"`
+//  }
+//
+func RegisterVariantClasses(variant string, classes map[string]string) {
+	variantClasses[variant] = classes
+}
+
+// variantClass returns the classes for the value v of a variant.
+func variantClass(variant string, v reflect.Value) string {
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v = reflect.Zero(v.Type().Elem())
+		} else {
+			v = v.Elem()
+		}
+	}
+
+	var val string
+	switch v.Kind() {
+	case reflect.String:
+		val = v.String()
+	case reflect.Bool:
+		val = strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		val = strconv.FormatUint(v.Uint(), 10)
+	default:
+		panic(&ConversionError{Kind: v.Kind(), Msg: "variant field must be a string, bool or integer"})
+	}
+
+	return variantClasses[variant][val]
+}
+
+// appendClasses adds classes to currentClasses, skipping classes that are
+// already present. Unlike AddClass, the order of the classes is preserved.
+func appendClasses(currentClasses, classes string) string {
+
+	uniq := splitClasses(currentClasses)
+
+	out := strings.TrimSpace(currentClasses)
+	for _, class := range strings.Fields(classes) {
+		if _, exists := uniq[class]; exists {
+			continue
+		}
+		uniq[class] = struct{}{}
+
+		if out != "" {
+			out = out + " "
+		}
+		out = out + class
+	}
+
+	return out
+}
@@ -0,0 +1,86 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build !native
+// +build !native
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// IntersectionOptions are the options of an IntersectionObserver.
+// Any struct (or map) with the same keys can be used instead.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/IntersectionObserver/IntersectionObserver
+type IntersectionOptions struct {
+	// Root is the element used as the viewport. The default is the browser's viewport.
+	Root *js.Object `react:"root,omitempty"`
+
+	// RootMargin grows or shrinks the root's bounding box (eg. "200px 0px").
+	RootMargin string `react:"rootMargin,omitempty"`
+
+	// Threshold is a float64 or []float64 between 0 and 1. It is the proportion of
+	// the element that must be visible for it to be considered visible.
+	Threshold interface{} `react:"threshold,omitempty"`
+}
+
+// ObserveVisibility is a hook that calls cb when the element of ref becomes visible
+// or hidden (eg. to lazy-load images or trigger animations while scrolling).
+// opts is converted using SToMap (see IntersectionOptions) and is read when the component mounts.
+// The observer is disconnected when the component unmounts.
+//
+// If the browser doesn't support IntersectionObserver, cb is called with true.
+//
+// Example:
+//
+//  ref := react.React.Call("useRef", nil)
+//  react.ObserveVisibility(ref, react.IntersectionOptions{RootMargin: "200px"}, func(visible bool) {
+//      if visible {
+//          setLoaded(true)
+//      }
+//  })
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/API/Intersection_Observer_API
+func ObserveVisibility(ref *js.Object, opts interface{}, cb func(visible bool)) {
+
+	type visibilityState struct {
+		cb func(visible bool)
+	}
+
+	st := useGoRef(func() interface{} {
+		return &visibilityState{}
+	}).(*visibilityState)
+	st.cb = cb
+
+	useEffect(func() func() {
+		node := ref.Get("current")
+		if node == nil || node == js.Undefined {
+			return nil
+		}
+
+		observerClass := js.Global.Get("IntersectionObserver")
+		if observerClass == js.Undefined {
+			st.cb(true)
+			return nil
+		}
+
+		var options interface{} = js.Undefined
+		if mp := SToMap(opts); mp != nil {
+			options = mp
+		}
+
+		observer := observerClass.New(func(entries *js.Object) {
+			if entries.Length() == 0 {
+				return
+			}
+			// The last entry is the most recent
+			st.cb(entries.Index(entries.Length() - 1).Get("isIntersecting").Bool())
+		}, options)
+		observer.Call("observe", node)
+
+		return func() {
+			observer.Call("disconnect")
+		}
+	}, []interface{}{})
+}
@@ -0,0 +1,25 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+//go:build native
+// +build native
+
+package react
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// IntersectionOptions are the options of an IntersectionObserver.
+type IntersectionOptions struct {
+	Root       *js.Object  `react:"root,omitempty"`
+	RootMargin string      `react:"rootMargin,omitempty"`
+	Threshold  interface{} `react:"threshold,omitempty"`
+}
+
+// ObserveVisibility calls cb with true in React Native, since there is no IntersectionObserver.
+func ObserveVisibility(ref *js.Object, opts interface{}, cb func(visible bool)) {
+	useEffect(func() func() {
+		cb(true)
+		return nil
+	}, []interface{}{})
+}
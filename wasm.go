@@ -0,0 +1,112 @@
+// Copyright 2018-20 PJ Engineering and Business Solutions Pty. Ltd. All rights reserved.
+
+package react
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// WASMState is returned by UseWASM.
+type WASMState struct {
+	// Instance is the WebAssembly.Instance. It is nil while loading.
+	Instance *js.Object
+
+	// Module is the compiled WebAssembly.Module. It is nil while loading.
+	Module *js.Object
+
+	// Loading is true until the module has been instantiated or failed.
+	Loading bool
+
+	// Error is set if the module failed to load.
+	Error error
+}
+
+// Call calls an exported function of the instance.
+func (s WASMState) Call(name string, args ...interface{}) (_ *js.Object, rErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err, ok := e.(*js.Error)
+			if !ok {
+				panic(e)
+			}
+			rErr = err
+		}
+	}()
+
+	if s.Instance == nil {
+		return nil, errors.New("UseWASM: module not loaded")
+	}
+
+	fn := s.Instance.Get("exports").Get(name)
+	if fn == js.Undefined {
+		return nil, errors.New("UseWASM: " + name + " is not exported")
+	}
+
+	return fn.Invoke(args...), nil
+}
+
+// UseWASM is a hook that fetches, compiles and instantiates a WebAssembly module.
+// The optional imports are passed to the module. If url changes, the state is reset
+// and the new module is loaded. No clean up is required for WebAssembly modules.
+//
+// Example:
+//
+//  wasm := react.UseWASM("/add.wasm")
+//  if wasm.Loading {
+//      return elements.P(nil, "Loading...")
+//  }
+//  res, err := wasm.Call("add", 1, 2)
+//
+// See: https://developer.mozilla.org/en-US/docs/WebAssembly/JavaScript_interface/instantiateStreaming
+func UseWASM(url string, imports ...map[string]interface{}) WASMState {
+
+	state, setState := useState(js.M{"url": url})
+
+	useEffect(func() func() {
+		stale := false
+
+		go func() {
+			var importObject interface{} = js.M{}
+			if len(imports) > 0 && imports[0] != nil {
+				importObject = imports[0]
+			}
+
+			res, err := JSFnPromise("WebAssembly.instantiateStreaming", js.Global.Call("fetch", url), importObject)
+			if stale {
+				return
+			}
+
+			if err != nil {
+				setState(js.M{"url": url, "loaded": true, "error": err.Error()})
+				return
+			}
+
+			setState(js.M{
+				"url":      url,
+				"loaded":   true,
+				"instance": res.Get("instance"),
+				"module":   res.Get("module"),
+			})
+		}()
+
+		return func() {
+			stale = true
+		}
+	}, []interface{}{url})
+
+	if state.Get("url").String() != url || !state.Get("loaded").Bool() {
+		// url has changed, but the effect hasn't run yet
+		return WASMState{Loading: true}
+	}
+
+	if e := state.Get("error"); e != js.Undefined {
+		return WASMState{Error: errors.New(e.String())}
+	}
+
+	return WASMState{
+		Instance: state.Get("instance"),
+		Module:   state.Get("module"),
+	}
+}